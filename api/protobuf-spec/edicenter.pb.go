@@ -173,10 +173,67 @@ func (m *PopulatedSystemBriefInfo) GetEconomy() string {
 	return ""
 }
 
+type StarInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	IsScoopable          bool     `protobuf:"varint,3,opt,name=is_scoopable,json=isScoopable,proto3" json:"is_scoopable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StarInfo) Reset()         { *m = StarInfo{} }
+func (m *StarInfo) String() string { return proto.CompactTextString(m) }
+func (*StarInfo) ProtoMessage()    {}
+func (*StarInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{2}
+}
+
+func (m *StarInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarInfo.Unmarshal(m, b)
+}
+func (m *StarInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarInfo.Marshal(b, m, deterministic)
+}
+func (m *StarInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarInfo.Merge(m, src)
+}
+func (m *StarInfo) XXX_Size() int {
+	return xxx_messageInfo_StarInfo.Size(m)
+}
+func (m *StarInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarInfo proto.InternalMessageInfo
+
+func (m *StarInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StarInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StarInfo) GetIsScoopable() bool {
+	if m != nil {
+		return m.IsScoopable
+	}
+	return false
+}
+
 type SystemSummary struct {
 	Name                 string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Coords               *Point3D                  `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
 	PopSystemInfo        *PopulatedSystemBriefInfo `protobuf:"bytes,3,opt,name=pop_system_info,json=popSystemInfo,proto3" json:"pop_system_info,omitempty"`
+	PrimaryStar          *StarInfo                 `protobuf:"bytes,4,opt,name=primary_star,json=primaryStar,proto3" json:"primary_star,omitempty"`
+	LastEddnUpdate       int64                     `protobuf:"varint,5,opt,name=last_eddn_update,json=lastEddnUpdate,proto3" json:"last_eddn_update,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -186,7 +243,7 @@ func (m *SystemSummary) Reset()         { *m = SystemSummary{} }
 func (m *SystemSummary) String() string { return proto.CompactTextString(m) }
 func (*SystemSummary) ProtoMessage()    {}
 func (*SystemSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{2}
+	return fileDescriptor_e4ecdf5e536227fd, []int{3}
 }
 
 func (m *SystemSummary) XXX_Unmarshal(b []byte) error {
@@ -228,6 +285,20 @@ func (m *SystemSummary) GetPopSystemInfo() *PopulatedSystemBriefInfo {
 	return nil
 }
 
+func (m *SystemSummary) GetPrimaryStar() *StarInfo {
+	if m != nil {
+		return m.PrimaryStar
+	}
+	return nil
+}
+
+func (m *SystemSummary) GetLastEddnUpdate() int64 {
+	if m != nil {
+		return m.LastEddnUpdate
+	}
+	return 0
+}
+
 type DockableStationShortInfo struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	LandingPad           string   `protobuf:"bytes,2,opt,name=landing_pad,json=landingPad,proto3" json:"landing_pad,omitempty"`
@@ -242,7 +313,7 @@ func (m *DockableStationShortInfo) Reset()         { *m = DockableStationShortIn
 func (m *DockableStationShortInfo) String() string { return proto.CompactTextString(m) }
 func (*DockableStationShortInfo) ProtoMessage()    {}
 func (*DockableStationShortInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{3}
+	return fileDescriptor_e4ecdf5e536227fd, []int{4}
 }
 
 func (m *DockableStationShortInfo) XXX_Unmarshal(b []byte) error {
@@ -306,7 +377,7 @@ func (m *HumanWorldStat) Reset()         { *m = HumanWorldStat{} }
 func (m *HumanWorldStat) String() string { return proto.CompactTextString(m) }
 func (*HumanWorldStat) ProtoMessage()    {}
 func (*HumanWorldStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{4}
+	return fileDescriptor_e4ecdf5e536227fd, []int{5}
 }
 
 func (m *HumanWorldStat) XXX_Unmarshal(b []byte) error {
@@ -375,7 +446,7 @@ func (m *ShortFactionState) Reset()         { *m = ShortFactionState{} }
 func (m *ShortFactionState) String() string { return proto.CompactTextString(m) }
 func (*ShortFactionState) ProtoMessage()    {}
 func (*ShortFactionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{5}
+	return fileDescriptor_e4ecdf5e536227fd, []int{6}
 }
 
 func (m *ShortFactionState) XXX_Unmarshal(b []byte) error {
@@ -422,6 +493,7 @@ type InterestingSystem4State struct {
 	Coords               *Point3D             `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
 	Population           int64                `protobuf:"varint,3,opt,name=population,proto3" json:"population,omitempty"`
 	FactionStates        []*ShortFactionState `protobuf:"bytes,4,rep,name=faction_states,json=factionStates,proto3" json:"faction_states,omitempty"`
+	LastEddnUpdate       int64                `protobuf:"varint,5,opt,name=last_eddn_update,json=lastEddnUpdate,proto3" json:"last_eddn_update,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -431,7 +503,7 @@ func (m *InterestingSystem4State) Reset()         { *m = InterestingSystem4State
 func (m *InterestingSystem4State) String() string { return proto.CompactTextString(m) }
 func (*InterestingSystem4State) ProtoMessage()    {}
 func (*InterestingSystem4State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{6}
+	return fileDescriptor_e4ecdf5e536227fd, []int{7}
 }
 
 func (m *InterestingSystem4State) XXX_Unmarshal(b []byte) error {
@@ -480,6 +552,13 @@ func (m *InterestingSystem4State) GetFactionStates() []*ShortFactionState {
 	return nil
 }
 
+func (m *InterestingSystem4State) GetLastEddnUpdate() int64 {
+	if m != nil {
+		return m.LastEddnUpdate
+	}
+	return 0
+}
+
 type SystemByNameRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -491,7 +570,7 @@ func (m *SystemByNameRequest) Reset()         { *m = SystemByNameRequest{} }
 func (m *SystemByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*SystemByNameRequest) ProtoMessage()    {}
 func (*SystemByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{7}
+	return fileDescriptor_e4ecdf5e536227fd, []int{8}
 }
 
 func (m *SystemByNameRequest) XXX_Unmarshal(b []byte) error {
@@ -531,7 +610,7 @@ func (m *SystemsDistanceRequest) Reset()         { *m = SystemsDistanceRequest{}
 func (m *SystemsDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*SystemsDistanceRequest) ProtoMessage()    {}
 func (*SystemsDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{8}
+	return fileDescriptor_e4ecdf5e536227fd, []int{9}
 }
 
 func (m *SystemsDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -578,7 +657,7 @@ func (m *SystemsDistanceReply) Reset()         { *m = SystemsDistanceReply{} }
 func (m *SystemsDistanceReply) String() string { return proto.CompactTextString(m) }
 func (*SystemsDistanceReply) ProtoMessage()    {}
 func (*SystemsDistanceReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{9}
+	return fileDescriptor_e4ecdf5e536227fd, []int{10}
 }
 
 func (m *SystemsDistanceReply) XXX_Unmarshal(b []byte) error {
@@ -625,7 +704,7 @@ func (m *SystemSummaryReply) Reset()         { *m = SystemSummaryReply{} }
 func (m *SystemSummaryReply) String() string { return proto.CompactTextString(m) }
 func (*SystemSummaryReply) ProtoMessage()    {}
 func (*SystemSummaryReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{10}
+	return fileDescriptor_e4ecdf5e536227fd, []int{11}
 }
 
 func (m *SystemSummaryReply) XXX_Unmarshal(b []byte) error {
@@ -673,7 +752,7 @@ func (m *DockableStationsReply) Reset()         { *m = DockableStationsReply{} }
 func (m *DockableStationsReply) String() string { return proto.CompactTextString(m) }
 func (*DockableStationsReply) ProtoMessage()    {}
 func (*DockableStationsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{11}
+	return fileDescriptor_e4ecdf5e536227fd, []int{12}
 }
 
 func (m *DockableStationsReply) XXX_Unmarshal(b []byte) error {
@@ -729,7 +808,7 @@ func (m *MostVisitedSystemsRequest) Reset()         { *m = MostVisitedSystemsReq
 func (m *MostVisitedSystemsRequest) String() string { return proto.CompactTextString(m) }
 func (*MostVisitedSystemsRequest) ProtoMessage()    {}
 func (*MostVisitedSystemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{12}
+	return fileDescriptor_e4ecdf5e536227fd, []int{13}
 }
 
 func (m *MostVisitedSystemsRequest) XXX_Unmarshal(b []byte) error {
@@ -791,7 +870,7 @@ func (m *SystemVisitsStat) Reset()         { *m = SystemVisitsStat{} }
 func (m *SystemVisitsStat) String() string { return proto.CompactTextString(m) }
 func (*SystemVisitsStat) ProtoMessage()    {}
 func (*SystemVisitsStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{13}
+	return fileDescriptor_e4ecdf5e536227fd, []int{14}
 }
 
 func (m *SystemVisitsStat) XXX_Unmarshal(b []byte) error {
@@ -846,7 +925,7 @@ func (m *MostVisitedSystemsReply) Reset()         { *m = MostVisitedSystemsReply
 func (m *MostVisitedSystemsReply) String() string { return proto.CompactTextString(m) }
 func (*MostVisitedSystemsReply) ProtoMessage()    {}
 func (*MostVisitedSystemsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{14}
+	return fileDescriptor_e4ecdf5e536227fd, []int{15}
 }
 
 func (m *MostVisitedSystemsReply) XXX_Unmarshal(b []byte) error {
@@ -902,7 +981,7 @@ func (m *InterestingSystem4StateRequest) Reset()         { *m = InterestingSyste
 func (m *InterestingSystem4StateRequest) String() string { return proto.CompactTextString(m) }
 func (*InterestingSystem4StateRequest) ProtoMessage()    {}
 func (*InterestingSystem4StateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{15}
+	return fileDescriptor_e4ecdf5e536227fd, []int{16}
 }
 
 func (m *InterestingSystem4StateRequest) XXX_Unmarshal(b []byte) error {
@@ -963,7 +1042,7 @@ func (m *InterestingSystem4StateReply) Reset()         { *m = InterestingSystem4
 func (m *InterestingSystem4StateReply) String() string { return proto.CompactTextString(m) }
 func (*InterestingSystem4StateReply) ProtoMessage()    {}
 func (*InterestingSystem4StateReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{16}
+	return fileDescriptor_e4ecdf5e536227fd, []int{17}
 }
 
 func (m *InterestingSystem4StateReply) XXX_Unmarshal(b []byte) error {
@@ -1011,7 +1090,7 @@ func (m *ActivityStatItem) Reset()         { *m = ActivityStatItem{} }
 func (m *ActivityStatItem) String() string { return proto.CompactTextString(m) }
 func (*ActivityStatItem) ProtoMessage()    {}
 func (*ActivityStatItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{17}
+	return fileDescriptor_e4ecdf5e536227fd, []int{18}
 }
 
 func (m *ActivityStatItem) XXX_Unmarshal(b []byte) error {
@@ -1056,6 +1135,7 @@ func (m *ActivityStatItem) GetNumDocks() int64 {
 type ActivityStatRequest struct {
 	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
 	MaxDistance          float64  `protobuf:"fixed64,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Window               int64    `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1065,7 +1145,7 @@ func (m *ActivityStatRequest) Reset()         { *m = ActivityStatRequest{} }
 func (m *ActivityStatRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivityStatRequest) ProtoMessage()    {}
 func (*ActivityStatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{18}
+	return fileDescriptor_e4ecdf5e536227fd, []int{19}
 }
 
 func (m *ActivityStatRequest) XXX_Unmarshal(b []byte) error {
@@ -1100,6 +1180,13 @@ func (m *ActivityStatRequest) GetMaxDistance() float64 {
 	return 0
 }
 
+func (m *ActivityStatRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 type ActivityStatReply struct {
 	Error                string              `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	StatItems            []*ActivityStatItem `protobuf:"bytes,2,rep,name=stat_items,json=statItems,proto3" json:"stat_items,omitempty"`
@@ -1112,7 +1199,7 @@ func (m *ActivityStatReply) Reset()         { *m = ActivityStatReply{} }
 func (m *ActivityStatReply) String() string { return proto.CompactTextString(m) }
 func (*ActivityStatReply) ProtoMessage()    {}
 func (*ActivityStatReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4ecdf5e536227fd, []int{19}
+	return fileDescriptor_e4ecdf5e536227fd, []int{20}
 }
 
 func (m *ActivityStatReply) XXX_Unmarshal(b []byte) error {
@@ -1147,219 +1234,3479 @@ func (m *ActivityStatReply) GetStatItems() []*ActivityStatItem {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Point3D)(nil), "api.Point3D")
-	proto.RegisterType((*PopulatedSystemBriefInfo)(nil), "api.PopulatedSystemBriefInfo")
-	proto.RegisterType((*SystemSummary)(nil), "api.SystemSummary")
-	proto.RegisterType((*DockableStationShortInfo)(nil), "api.DockableStationShortInfo")
-	proto.RegisterType((*HumanWorldStat)(nil), "api.HumanWorldStat")
-	proto.RegisterType((*ShortFactionState)(nil), "api.ShortFactionState")
-	proto.RegisterType((*InterestingSystem4State)(nil), "api.InterestingSystem4State")
-	proto.RegisterType((*SystemByNameRequest)(nil), "api.SystemByNameRequest")
-	proto.RegisterType((*SystemsDistanceRequest)(nil), "api.SystemsDistanceRequest")
-	proto.RegisterType((*SystemsDistanceReply)(nil), "api.SystemsDistanceReply")
-	proto.RegisterType((*SystemSummaryReply)(nil), "api.SystemSummaryReply")
-	proto.RegisterType((*DockableStationsReply)(nil), "api.DockableStationsReply")
-	proto.RegisterType((*MostVisitedSystemsRequest)(nil), "api.MostVisitedSystemsRequest")
-	proto.RegisterType((*SystemVisitsStat)(nil), "api.SystemVisitsStat")
-	proto.RegisterType((*MostVisitedSystemsReply)(nil), "api.MostVisitedSystemsReply")
-	proto.RegisterType((*InterestingSystem4StateRequest)(nil), "api.InterestingSystem4StateRequest")
-	proto.RegisterType((*InterestingSystem4StateReply)(nil), "api.InterestingSystem4StateReply")
-	proto.RegisterType((*ActivityStatItem)(nil), "api.ActivityStatItem")
-	proto.RegisterType((*ActivityStatRequest)(nil), "api.ActivityStatRequest")
-	proto.RegisterType((*ActivityStatReply)(nil), "api.ActivityStatReply")
+type StationActivityRequest struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	MaxDistance          float64  `protobuf:"fixed64,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Window               int64    `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("protobuf-spec/edicenter.proto", fileDescriptor_e4ecdf5e536227fd) }
-
-var fileDescriptor_e4ecdf5e536227fd = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x0e, 0x4d, 0xc7, 0x8e, 0x46, 0xb6, 0x63, 0xd3, 0x7f, 0x8a, 0xec, 0x38, 0xce, 0xa6, 0x05,
-	0x5c, 0xb4, 0x95, 0x51, 0x3b, 0x28, 0xd0, 0x43, 0x0f, 0x4e, 0xec, 0x28, 0x6e, 0x91, 0xc2, 0xa0,
-	0x81, 0x26, 0x97, 0x82, 0x58, 0x53, 0x2b, 0x66, 0x13, 0x92, 0xcb, 0x72, 0x97, 0x86, 0x98, 0x7b,
-	0x81, 0xe6, 0x56, 0xa0, 0xe8, 0x43, 0xf4, 0xda, 0x37, 0xe8, 0x9b, 0x15, 0xfb, 0x43, 0x8a, 0x94,
-	0x44, 0xb5, 0x40, 0x6f, 0xfc, 0x66, 0x67, 0x67, 0x67, 0xbf, 0x99, 0x6f, 0xb8, 0xf0, 0x30, 0x49,
-	0x99, 0x60, 0x37, 0xd9, 0xf0, 0x4b, 0x9e, 0x10, 0xff, 0x98, 0x0c, 0xa8, 0x4f, 0x62, 0x41, 0xd2,
-	0x9e, 0xb2, 0x3b, 0x36, 0x4e, 0x68, 0x77, 0x2f, 0x60, 0x2c, 0x08, 0xc9, 0x71, 0xe1, 0x7a, 0x4c,
-	0xa2, 0x44, 0xe4, 0xda, 0x03, 0x9d, 0xc2, 0xf2, 0x15, 0xa3, 0xb1, 0x38, 0x3d, 0x77, 0x56, 0xc0,
-	0x1a, 0x75, 0xac, 0x43, 0xeb, 0xc8, 0x72, 0xad, 0x91, 0x44, 0x79, 0x67, 0x41, 0xa3, 0x5c, 0xa2,
-	0x0f, 0x1d, 0x5b, 0xa3, 0x0f, 0xe8, 0xe3, 0x02, 0x74, 0xae, 0x58, 0x92, 0x85, 0x58, 0x90, 0xc1,
-	0x75, 0xce, 0x05, 0x89, 0x9e, 0xa5, 0x94, 0x0c, 0x2f, 0xe3, 0x21, 0x73, 0x0e, 0x00, 0x70, 0x18,
-	0x92, 0x80, 0xe2, 0xd8, 0x27, 0x2a, 0x5e, 0xcb, 0xad, 0x58, 0xe4, 0x7a, 0xc0, 0x6e, 0x49, 0x1a,
-	0x47, 0x24, 0x16, 0xea, 0x84, 0x96, 0x5b, 0xb1, 0x38, 0x1d, 0x58, 0x1e, 0x62, 0x5f, 0x50, 0x16,
-	0xab, 0x03, 0x5b, 0x6e, 0x01, 0x9d, 0x27, 0xb0, 0x6a, 0x3e, 0x3d, 0x2e, 0xb0, 0x20, 0x9d, 0x45,
-	0xb5, 0xbe, 0x62, 0x8c, 0xd7, 0xd2, 0x26, 0xc3, 0x27, 0x3a, 0x35, 0x19, 0xe1, 0xee, 0xa1, 0x75,
-	0x64, 0xbb, 0x15, 0x8b, 0x0c, 0x9f, 0x12, 0x4e, 0xd2, 0x5b, 0xd2, 0x59, 0xd2, 0xe1, 0x0d, 0x74,
-	0xba, 0x70, 0x8f, 0x13, 0x3f, 0x4b, 0xa9, 0xc8, 0x3b, 0xcb, 0x6a, 0xa9, 0xc4, 0x72, 0x17, 0xf1,
-	0x59, 0xcc, 0xa2, 0xbc, 0x73, 0x4f, 0xef, 0x32, 0x10, 0xfd, 0x66, 0xc1, 0xaa, 0xa6, 0xe0, 0x3a,
-	0x8b, 0x22, 0x9c, 0xe6, 0x8e, 0x03, 0x8b, 0x31, 0x8e, 0x8a, 0xab, 0xab, 0x6f, 0xe7, 0x13, 0x58,
-	0xf2, 0x19, 0x4b, 0x07, 0x5c, 0x5d, 0xb8, 0x7d, 0xb2, 0xd2, 0xc3, 0x09, 0xed, 0x19, 0xe6, 0x5d,
-	0xb3, 0xe6, 0x5c, 0xc0, 0xfd, 0x84, 0x25, 0x1e, 0x57, 0xe1, 0x3c, 0x1a, 0x0f, 0x99, 0xa2, 0xa0,
-	0x7d, 0xf2, 0xd0, 0xb8, 0xcf, 0xa6, 0xdc, 0x5d, 0x4d, 0x58, 0xa2, 0x6d, 0x12, 0xa2, 0x8f, 0x16,
-	0x74, 0xce, 0x99, 0xff, 0x1e, 0xdf, 0x84, 0x44, 0x92, 0x22, 0xb9, 0x79, 0xcb, 0x52, 0xa1, 0xca,
-	0x33, 0x2b, 0xbb, 0x47, 0xd0, 0x0e, 0x71, 0x3c, 0xa0, 0x71, 0xe0, 0x25, 0x78, 0x50, 0xd4, 0xc4,
-	0x98, 0xae, 0xf0, 0x40, 0x52, 0x33, 0xa0, 0x5c, 0xa8, 0x8a, 0xea, 0x2e, 0x28, 0xb1, 0xb3, 0x0f,
-	0xad, 0x24, 0xc4, 0x31, 0x11, 0x38, 0xcd, 0x55, 0x45, 0xee, 0xb9, 0x63, 0x03, 0xfa, 0xd3, 0x82,
-	0xb5, 0x97, 0x59, 0x84, 0xe3, 0xd7, 0x2c, 0x0d, 0x07, 0x32, 0x1b, 0xc9, 0xa5, 0xbe, 0x21, 0x57,
-	0x49, 0xd8, 0x6e, 0x01, 0x55, 0x05, 0x74, 0xbe, 0x9a, 0x27, 0xdb, 0x2d, 0xb1, 0x5c, 0x33, 0x75,
-	0xe6, 0x2a, 0x05, 0xdb, 0x2d, 0xb1, 0xf3, 0x29, 0xac, 0xbd, 0x95, 0x67, 0x78, 0xa5, 0xc7, 0xa2,
-	0xf2, 0x58, 0x55, 0xd6, 0x17, 0x85, 0xdb, 0xbf, 0xb4, 0x06, 0xfa, 0x09, 0x36, 0x14, 0x4f, 0x2f,
-	0xaa, 0xfd, 0x34, 0x8b, 0xaf, 0x2d, 0xb8, 0xab, 0x1b, 0x50, 0x33, 0xa5, 0xc1, 0x44, 0xe3, 0xdb,
-	0x93, 0x8d, 0x8f, 0xfe, 0xb2, 0x60, 0xf7, 0x52, 0x8a, 0x93, 0x70, 0x41, 0xe3, 0x40, 0x17, 0xec,
-	0x69, 0xf3, 0x29, 0xff, 0xad, 0x67, 0xea, 0x97, 0xb2, 0xa7, 0xfa, 0xfd, 0x5b, 0x58, 0xab, 0x89,
-	0x46, 0x72, 0x63, 0x1f, 0xb5, 0x4f, 0x76, 0x54, 0xb4, 0xa9, 0xfb, 0xba, 0xab, 0x55, 0x35, 0x71,
-	0xf4, 0x19, 0x6c, 0x9a, 0x6e, 0xcb, 0x7f, 0xc0, 0x11, 0x71, 0xc9, 0xcf, 0x19, 0xe1, 0x62, 0x56,
-	0xbe, 0xe8, 0x1c, 0x76, 0xb4, 0x2b, 0x3f, 0x37, 0xbd, 0x51, 0x78, 0x6f, 0xc1, 0x5d, 0xe9, 0xf1,
-	0x95, 0x71, 0xd7, 0xa0, 0xb0, 0x9e, 0x14, 0x2c, 0x2a, 0x80, 0x5e, 0xc2, 0xd6, 0x54, 0x94, 0x24,
-	0xcc, 0xa5, 0x37, 0x49, 0x53, 0x96, 0x16, 0x31, 0x14, 0xa8, 0x35, 0xe6, 0x42, 0xbd, 0x31, 0xd1,
-	0x1b, 0x70, 0x6a, 0xc2, 0x9c, 0x17, 0xe7, 0x0b, 0x58, 0xe6, 0xda, 0xcb, 0x90, 0xed, 0x68, 0x7a,
-	0x6a, 0xfb, 0x0b, 0x17, 0xf4, 0x87, 0x05, 0xdb, 0x13, 0x02, 0xe3, 0xf3, 0xa2, 0x7f, 0x53, 0xeb,
-	0x6b, 0xbb, 0x14, 0x74, 0x93, 0x48, 0x2b, 0x6d, 0xff, 0x39, 0x6c, 0xf0, 0x2c, 0x08, 0x08, 0x17,
-	0x64, 0xe0, 0x15, 0xb2, 0xb1, 0x0f, 0xed, 0xa3, 0x96, 0xbb, 0x5e, 0x2e, 0x18, 0xc2, 0xd0, 0x2f,
-	0x16, 0x3c, 0x78, 0xc5, 0xb8, 0xf8, 0x91, 0x72, 0x3a, 0x36, 0x17, 0x55, 0xd8, 0x81, 0x25, 0x96,
-	0xd2, 0x80, 0xc6, 0x26, 0x39, 0x83, 0x9c, 0xc7, 0xb0, 0x12, 0xe1, 0x91, 0x37, 0xc1, 0x63, 0x3b,
-	0xc2, 0xa3, 0xa2, 0x02, 0xce, 0x2e, 0x2c, 0x4b, 0x17, 0x1c, 0x10, 0xd3, 0x61, 0x4b, 0x11, 0x1e,
-	0x9d, 0x05, 0x4a, 0x09, 0x21, 0x8d, 0xa8, 0x30, 0x82, 0xd3, 0x00, 0xbd, 0x81, 0x75, 0x7d, 0xb6,
-	0x4a, 0x84, 0x2b, 0xd5, 0x37, 0xe8, 0xc8, 0x67, 0x99, 0xf9, 0x0b, 0xd8, 0xae, 0x06, 0xf3, 0x86,
-	0x0d, 0xfa, 0xdd, 0x82, 0xdd, 0x59, 0x37, 0x6c, 0xe6, 0xfe, 0x0c, 0x36, 0xcc, 0x3c, 0xbd, 0x95,
-	0x7b, 0x94, 0x08, 0x4c, 0x11, 0xb6, 0x2b, 0x35, 0x1e, 0x67, 0xea, 0xde, 0xe7, 0x63, 0x8b, 0x4a,
-	0xfd, 0x11, 0xb4, 0x05, 0x13, 0x38, 0xf4, 0x74, 0xb2, 0x46, 0x63, 0xca, 0xf4, 0x5c, 0x5a, 0xd0,
-	0xaf, 0x16, 0x1c, 0x34, 0x28, 0x7b, 0x8e, 0x60, 0x64, 0x41, 0x8c, 0x24, 0x17, 0x54, 0x41, 0x0d,
-	0x52, 0x6c, 0xd3, 0xd8, 0x4b, 0x58, 0x52, 0xb2, 0x4d, 0xe3, 0x2b, 0x96, 0x4c, 0x55, 0x6a, 0x71,
-	0xaa, 0x52, 0x28, 0x84, 0xfd, 0xc6, 0x4c, 0x9a, 0x49, 0xfa, 0x7a, 0x3c, 0x92, 0x35, 0x35, 0xfb,
-	0x8a, 0x9a, 0xa6, 0x48, 0x85, 0x33, 0x7a, 0x07, 0xeb, 0x67, 0xbe, 0xa0, 0xb7, 0x54, 0xe4, 0x72,
-	0xe5, 0x52, 0x90, 0x48, 0xfe, 0x0f, 0x04, 0x8d, 0x08, 0x17, 0x38, 0x4a, 0xcc, 0x80, 0x1f, 0x1b,
-	0x9c, 0x3d, 0x68, 0xc5, 0x59, 0xe4, 0xbd, 0xcb, 0xa2, 0xa4, 0x9c, 0xf1, 0x71, 0x16, 0x7d, 0x27,
-	0x71, 0xb1, 0x38, 0x60, 0xfe, 0xfb, 0x72, 0xc8, 0xc7, 0x59, 0x24, 0x65, 0xc2, 0xd1, 0x15, 0x6c,
-	0x56, 0xcf, 0xfa, 0xff, 0x5d, 0x8d, 0x3c, 0xd8, 0xa8, 0x47, 0x6c, 0x26, 0xe8, 0x29, 0x80, 0x2c,
-	0x8e, 0x47, 0x2b, 0x1c, 0xe9, 0xf6, 0x99, 0xbc, 0xbf, 0xdb, 0xe2, 0xe6, 0x8b, 0x9f, 0xfc, 0xbd,
-	0x08, 0x2b, 0x17, 0xe7, 0x52, 0xd1, 0xcf, 0xd5, 0xab, 0xcc, 0xe9, 0x43, 0xbb, 0x4f, 0x44, 0x29,
-	0xab, 0xbd, 0x4a, 0x03, 0x4e, 0x0e, 0xcd, 0xee, 0x83, 0xd9, 0x8b, 0x49, 0x98, 0xa3, 0x3b, 0x4e,
-	0x1f, 0xd6, 0xfb, 0x44, 0xd4, 0xdf, 0x1d, 0x9d, 0xca, 0x86, 0xda, 0xb4, 0xee, 0xee, 0xce, 0x18,
-	0x66, 0x26, 0xd0, 0x2b, 0xd8, 0x94, 0x19, 0x4d, 0x0c, 0xb3, 0x39, 0xb1, 0xba, 0xb3, 0x26, 0x17,
-	0x2f, 0xc2, 0xbd, 0x86, 0xed, 0x3e, 0x11, 0xd3, 0x0a, 0x75, 0x0e, 0xd4, 0xb6, 0xc6, 0xe1, 0xd4,
-	0xdd, 0x6f, 0x5c, 0xd7, 0x81, 0x87, 0xd0, 0xed, 0x13, 0xd1, 0xf4, 0xfb, 0x7c, 0x32, 0xb7, 0x5d,
-	0xcd, 0x11, 0x8f, 0xe7, 0x3b, 0xe9, 0x73, 0x9e, 0xc1, 0x46, 0x9f, 0x88, 0x89, 0x17, 0xcb, 0x4e,
-	0x4f, 0x3f, 0xa1, 0x7b, 0xc5, 0x13, 0xba, 0x77, 0x21, 0x9f, 0xd0, 0xdd, 0x4d, 0x15, 0xb1, 0xee,
-	0x8c, 0xee, 0x38, 0xdf, 0x2b, 0x12, 0xfa, 0x38, 0xc4, 0xa3, 0xbc, 0xda, 0x1e, 0x86, 0xd5, 0x19,
-	0x5d, 0xdc, 0xdd, 0x99, 0xb1, 0xa2, 0x12, 0xba, 0x59, 0x52, 0x67, 0x9e, 0xfe, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0xfe, 0xa6, 0x85, 0xea, 0x0b, 0x00, 0x00,
+func (m *StationActivityRequest) Reset()         { *m = StationActivityRequest{} }
+func (m *StationActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*StationActivityRequest) ProtoMessage()    {}
+func (*StationActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{21}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *StationActivityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StationActivityRequest.Unmarshal(m, b)
+}
+func (m *StationActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StationActivityRequest.Marshal(b, m, deterministic)
+}
+func (m *StationActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StationActivityRequest.Merge(m, src)
+}
+func (m *StationActivityRequest) XXX_Size() int {
+	return xxx_messageInfo_StationActivityRequest.Size(m)
+}
+func (m *StationActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StationActivityRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_StationActivityRequest proto.InternalMessageInfo
 
-// EDInfoCenterClient is the client API for EDInfoCenter service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type EDInfoCenterClient interface {
-	GetDistance(ctx context.Context, in *SystemsDistanceRequest, opts ...grpc.CallOption) (*SystemsDistanceReply, error)
-	GetSystemSummary(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*SystemSummaryReply, error)
-	GetDockableStations(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*DockableStationsReply, error)
-	GetMostVisitedSystems(ctx context.Context, in *MostVisitedSystemsRequest, opts ...grpc.CallOption) (*MostVisitedSystemsReply, error)
-	GetInterestingSystem4State(ctx context.Context, in *InterestingSystem4StateRequest, opts ...grpc.CallOption) (*InterestingSystem4StateReply, error)
-	GetHumanWorldStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HumanWorldStat, error)
-	GetGalaxyActivityStat(ctx context.Context, in *ActivityStatRequest, opts ...grpc.CallOption) (*ActivityStatReply, error)
+func (m *StationActivityRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
 }
 
-type eDInfoCenterClient struct {
-	cc *grpc.ClientConn
+func (m *StationActivityRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
 }
 
-func NewEDInfoCenterClient(cc *grpc.ClientConn) EDInfoCenterClient {
-	return &eDInfoCenterClient{cc}
+func (m *StationActivityRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
 }
 
-func (c *eDInfoCenterClient) GetDistance(ctx context.Context, in *SystemsDistanceRequest, opts ...grpc.CallOption) (*SystemsDistanceReply, error) {
-	out := new(SystemsDistanceReply)
-	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetDistance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StationActivityRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *eDInfoCenterClient) GetSystemSummary(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*SystemSummaryReply, error) {
-	out := new(SystemSummaryReply)
-	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetSystemSummary", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type StationActivity struct {
+	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SystemName           string              `protobuf:"bytes,2,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
+	Distance             float64             `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	NumDocks             int64               `protobuf:"varint,4,opt,name=num_docks,json=numDocks,proto3" json:"num_docks,omitempty"`
+	Docks                []*ActivityStatItem `protobuf:"bytes,5,rep,name=docks,proto3" json:"docks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (c *eDInfoCenterClient) GetDockableStations(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*DockableStationsReply, error) {
-	out := new(DockableStationsReply)
-	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetDockableStations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StationActivity) Reset()         { *m = StationActivity{} }
+func (m *StationActivity) String() string { return proto.CompactTextString(m) }
+func (*StationActivity) ProtoMessage()    {}
+func (*StationActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{22}
+}
+
+func (m *StationActivity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StationActivity.Unmarshal(m, b)
+}
+func (m *StationActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StationActivity.Marshal(b, m, deterministic)
+}
+func (m *StationActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StationActivity.Merge(m, src)
+}
+func (m *StationActivity) XXX_Size() int {
+	return xxx_messageInfo_StationActivity.Size(m)
+}
+func (m *StationActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_StationActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StationActivity proto.InternalMessageInfo
+
+func (m *StationActivity) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *eDInfoCenterClient) GetMostVisitedSystems(ctx context.Context, in *MostVisitedSystemsRequest, opts ...grpc.CallOption) (*MostVisitedSystemsReply, error) {
-	out := new(MostVisitedSystemsReply)
-	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetMostVisitedSystems", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StationActivity) GetSystemName() string {
+	if m != nil {
+		return m.SystemName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *eDInfoCenterClient) GetInterestingSystem4State(ctx context.Context, in *InterestingSystem4StateRequest, opts ...grpc.CallOption) (*InterestingSystem4StateReply, error) {
-	out := new(InterestingSystem4StateReply)
-	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetInterestingSystem4State", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StationActivity) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
 	}
-	return out, nil
+	return 0
 }
 
-func (c *eDInfoCenterClient) GetHumanWorldStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HumanWorldStat, error) {
-	out := new(HumanWorldStat)
-	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetHumanWorldStat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StationActivity) GetNumDocks() int64 {
+	if m != nil {
+		return m.NumDocks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *eDInfoCenterClient) GetGalaxyActivityStat(ctx context.Context, in *ActivityStatRequest, opts ...grpc.CallOption) (*ActivityStatReply, error) {
-	out := new(ActivityStatReply)
-	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetGalaxyActivityStat", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StationActivity) GetDocks() []*ActivityStatItem {
+	if m != nil {
+		return m.Docks
 	}
-	return out, nil
+	return nil
 }
 
-// EDInfoCenterServer is the server API for EDInfoCenter service.
-type EDInfoCenterServer interface {
-	GetDistance(context.Context, *SystemsDistanceRequest) (*SystemsDistanceReply, error)
-	GetSystemSummary(context.Context, *SystemByNameRequest) (*SystemSummaryReply, error)
-	GetDockableStations(context.Context, *SystemByNameRequest) (*DockableStationsReply, error)
-	GetMostVisitedSystems(context.Context, *MostVisitedSystemsRequest) (*MostVisitedSystemsReply, error)
-	GetInterestingSystem4State(context.Context, *InterestingSystem4StateRequest) (*InterestingSystem4StateReply, error)
-	GetHumanWorldStat(context.Context, *empty.Empty) (*HumanWorldStat, error)
-	GetGalaxyActivityStat(context.Context, *ActivityStatRequest) (*ActivityStatReply, error)
+type TrendingSystemsRequest struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	MaxDistance          float64  `protobuf:"fixed64,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Window               int64    `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterEDInfoCenterServer(s *grpc.Server, srv EDInfoCenterServer) {
-	s.RegisterService(&_EDInfoCenter_serviceDesc, srv)
+func (m *TrendingSystemsRequest) Reset()         { *m = TrendingSystemsRequest{} }
+func (m *TrendingSystemsRequest) String() string { return proto.CompactTextString(m) }
+func (*TrendingSystemsRequest) ProtoMessage()    {}
+func (*TrendingSystemsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{23}
 }
 
-func _EDInfoCenter_GetDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemsDistanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *TrendingSystemsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrendingSystemsRequest.Unmarshal(m, b)
+}
+func (m *TrendingSystemsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrendingSystemsRequest.Marshal(b, m, deterministic)
+}
+func (m *TrendingSystemsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrendingSystemsRequest.Merge(m, src)
+}
+func (m *TrendingSystemsRequest) XXX_Size() int {
+	return xxx_messageInfo_TrendingSystemsRequest.Size(m)
+}
+func (m *TrendingSystemsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrendingSystemsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrendingSystemsRequest proto.InternalMessageInfo
+
+func (m *TrendingSystemsRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
 	}
-	if interceptor == nil {
+	return ""
+}
+
+func (m *TrendingSystemsRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+func (m *TrendingSystemsRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *TrendingSystemsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TrendingSystem struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Distance             float64  `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Visits               int64    `protobuf:"varint,3,opt,name=visits,proto3" json:"visits,omitempty"`
+	Rate                 float64  `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	BaselineRate         float64  `protobuf:"fixed64,5,opt,name=baseline_rate,json=baselineRate,proto3" json:"baseline_rate,omitempty"`
+	Score                float64  `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrendingSystem) Reset()         { *m = TrendingSystem{} }
+func (m *TrendingSystem) String() string { return proto.CompactTextString(m) }
+func (*TrendingSystem) ProtoMessage()    {}
+func (*TrendingSystem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{24}
+}
+
+func (m *TrendingSystem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrendingSystem.Unmarshal(m, b)
+}
+func (m *TrendingSystem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrendingSystem.Marshal(b, m, deterministic)
+}
+func (m *TrendingSystem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrendingSystem.Merge(m, src)
+}
+func (m *TrendingSystem) XXX_Size() int {
+	return xxx_messageInfo_TrendingSystem.Size(m)
+}
+func (m *TrendingSystem) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrendingSystem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrendingSystem proto.InternalMessageInfo
+
+func (m *TrendingSystem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TrendingSystem) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *TrendingSystem) GetVisits() int64 {
+	if m != nil {
+		return m.Visits
+	}
+	return 0
+}
+
+func (m *TrendingSystem) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *TrendingSystem) GetBaselineRate() float64 {
+	if m != nil {
+		return m.BaselineRate
+	}
+	return 0
+}
+
+func (m *TrendingSystem) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type TrendingSystemsReply struct {
+	Error                string            `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Systems              []*TrendingSystem `protobuf:"bytes,2,rep,name=systems,proto3" json:"systems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TrendingSystemsReply) Reset()         { *m = TrendingSystemsReply{} }
+func (m *TrendingSystemsReply) String() string { return proto.CompactTextString(m) }
+func (*TrendingSystemsReply) ProtoMessage()    {}
+func (*TrendingSystemsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{25}
+}
+
+func (m *TrendingSystemsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrendingSystemsReply.Unmarshal(m, b)
+}
+func (m *TrendingSystemsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrendingSystemsReply.Marshal(b, m, deterministic)
+}
+func (m *TrendingSystemsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrendingSystemsReply.Merge(m, src)
+}
+func (m *TrendingSystemsReply) XXX_Size() int {
+	return xxx_messageInfo_TrendingSystemsReply.Size(m)
+}
+func (m *TrendingSystemsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrendingSystemsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrendingSystemsReply proto.InternalMessageInfo
+
+func (m *TrendingSystemsReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TrendingSystemsReply) GetSystems() []*TrendingSystem {
+	if m != nil {
+		return m.Systems
+	}
+	return nil
+}
+
+type UploaderStatRequest struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	MaxDistance          float64  `protobuf:"fixed64,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Window               int64    `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploaderStatRequest) Reset()         { *m = UploaderStatRequest{} }
+func (m *UploaderStatRequest) String() string { return proto.CompactTextString(m) }
+func (*UploaderStatRequest) ProtoMessage()    {}
+func (*UploaderStatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{26}
+}
+
+func (m *UploaderStatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploaderStatRequest.Unmarshal(m, b)
+}
+func (m *UploaderStatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploaderStatRequest.Marshal(b, m, deterministic)
+}
+func (m *UploaderStatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploaderStatRequest.Merge(m, src)
+}
+func (m *UploaderStatRequest) XXX_Size() int {
+	return xxx_messageInfo_UploaderStatRequest.Size(m)
+}
+func (m *UploaderStatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploaderStatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploaderStatRequest proto.InternalMessageInfo
+
+func (m *UploaderStatRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *UploaderStatRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+func (m *UploaderStatRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type UploaderStatItem struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Uploaders            int64    `protobuf:"varint,2,opt,name=uploaders,proto3" json:"uploaders,omitempty"`
+	Messages             int64    `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploaderStatItem) Reset()         { *m = UploaderStatItem{} }
+func (m *UploaderStatItem) String() string { return proto.CompactTextString(m) }
+func (*UploaderStatItem) ProtoMessage()    {}
+func (*UploaderStatItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{27}
+}
+
+func (m *UploaderStatItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploaderStatItem.Unmarshal(m, b)
+}
+func (m *UploaderStatItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploaderStatItem.Marshal(b, m, deterministic)
+}
+func (m *UploaderStatItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploaderStatItem.Merge(m, src)
+}
+func (m *UploaderStatItem) XXX_Size() int {
+	return xxx_messageInfo_UploaderStatItem.Size(m)
+}
+func (m *UploaderStatItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploaderStatItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploaderStatItem proto.InternalMessageInfo
+
+func (m *UploaderStatItem) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *UploaderStatItem) GetUploaders() int64 {
+	if m != nil {
+		return m.Uploaders
+	}
+	return 0
+}
+
+func (m *UploaderStatItem) GetMessages() int64 {
+	if m != nil {
+		return m.Messages
+	}
+	return 0
+}
+
+type SoftwareShare struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uploaders            int64    `protobuf:"varint,2,opt,name=uploaders,proto3" json:"uploaders,omitempty"`
+	Messages             int64    `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SoftwareShare) Reset()         { *m = SoftwareShare{} }
+func (m *SoftwareShare) String() string { return proto.CompactTextString(m) }
+func (*SoftwareShare) ProtoMessage()    {}
+func (*SoftwareShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{28}
+}
+
+func (m *SoftwareShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SoftwareShare.Unmarshal(m, b)
+}
+func (m *SoftwareShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SoftwareShare.Marshal(b, m, deterministic)
+}
+func (m *SoftwareShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftwareShare.Merge(m, src)
+}
+func (m *SoftwareShare) XXX_Size() int {
+	return xxx_messageInfo_SoftwareShare.Size(m)
+}
+func (m *SoftwareShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftwareShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SoftwareShare proto.InternalMessageInfo
+
+func (m *SoftwareShare) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SoftwareShare) GetUploaders() int64 {
+	if m != nil {
+		return m.Uploaders
+	}
+	return 0
+}
+
+func (m *SoftwareShare) GetMessages() int64 {
+	if m != nil {
+		return m.Messages
+	}
+	return 0
+}
+
+type UploaderStatReply struct {
+	Error                string              `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Uploaders            int64               `protobuf:"varint,2,opt,name=uploaders,proto3" json:"uploaders,omitempty"`
+	Messages             int64               `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"`
+	Hours                []*UploaderStatItem `protobuf:"bytes,4,rep,name=hours,proto3" json:"hours,omitempty"`
+	Software             []*SoftwareShare    `protobuf:"bytes,5,rep,name=software,proto3" json:"software,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UploaderStatReply) Reset()         { *m = UploaderStatReply{} }
+func (m *UploaderStatReply) String() string { return proto.CompactTextString(m) }
+func (*UploaderStatReply) ProtoMessage()    {}
+func (*UploaderStatReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{29}
+}
+
+func (m *UploaderStatReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploaderStatReply.Unmarshal(m, b)
+}
+func (m *UploaderStatReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploaderStatReply.Marshal(b, m, deterministic)
+}
+func (m *UploaderStatReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploaderStatReply.Merge(m, src)
+}
+func (m *UploaderStatReply) XXX_Size() int {
+	return xxx_messageInfo_UploaderStatReply.Size(m)
+}
+func (m *UploaderStatReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploaderStatReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploaderStatReply proto.InternalMessageInfo
+
+func (m *UploaderStatReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *UploaderStatReply) GetUploaders() int64 {
+	if m != nil {
+		return m.Uploaders
+	}
+	return 0
+}
+
+func (m *UploaderStatReply) GetMessages() int64 {
+	if m != nil {
+		return m.Messages
+	}
+	return 0
+}
+
+func (m *UploaderStatReply) GetHours() []*UploaderStatItem {
+	if m != nil {
+		return m.Hours
+	}
+	return nil
+}
+
+func (m *UploaderStatReply) GetSoftware() []*SoftwareShare {
+	if m != nil {
+		return m.Software
+	}
+	return nil
+}
+
+type StationActivityReply struct {
+	Error                string             `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Stations             []*StationActivity `protobuf:"bytes,2,rep,name=stations,proto3" json:"stations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StationActivityReply) Reset()         { *m = StationActivityReply{} }
+func (m *StationActivityReply) String() string { return proto.CompactTextString(m) }
+func (*StationActivityReply) ProtoMessage()    {}
+func (*StationActivityReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{30}
+}
+
+func (m *StationActivityReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StationActivityReply.Unmarshal(m, b)
+}
+func (m *StationActivityReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StationActivityReply.Marshal(b, m, deterministic)
+}
+func (m *StationActivityReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StationActivityReply.Merge(m, src)
+}
+func (m *StationActivityReply) XXX_Size() int {
+	return xxx_messageInfo_StationActivityReply.Size(m)
+}
+func (m *StationActivityReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StationActivityReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StationActivityReply proto.InternalMessageInfo
+
+func (m *StationActivityReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *StationActivityReply) GetStations() []*StationActivity {
+	if m != nil {
+		return m.Stations
+	}
+	return nil
+}
+
+type RouteRequest struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination          string   `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	JumpRange            float64  `protobuf:"fixed64,3,opt,name=jump_range,json=jumpRange,proto3" json:"jump_range,omitempty"`
+	ScoopableOnly        bool     `protobuf:"varint,4,opt,name=scoopable_only,json=scoopableOnly,proto3" json:"scoopable_only,omitempty"`
+	UseNeutrons          bool     `protobuf:"varint,5,opt,name=use_neutrons,json=useNeutrons,proto3" json:"use_neutrons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteRequest) Reset()         { *m = RouteRequest{} }
+func (m *RouteRequest) String() string { return proto.CompactTextString(m) }
+func (*RouteRequest) ProtoMessage()    {}
+func (*RouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{31}
+}
+
+func (m *RouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteRequest.Unmarshal(m, b)
+}
+func (m *RouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteRequest.Marshal(b, m, deterministic)
+}
+func (m *RouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteRequest.Merge(m, src)
+}
+func (m *RouteRequest) XXX_Size() int {
+	return xxx_messageInfo_RouteRequest.Size(m)
+}
+func (m *RouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteRequest proto.InternalMessageInfo
+
+func (m *RouteRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *RouteRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *RouteRequest) GetJumpRange() float64 {
+	if m != nil {
+		return m.JumpRange
+	}
+	return 0
+}
+
+func (m *RouteRequest) GetScoopableOnly() bool {
+	if m != nil {
+		return m.ScoopableOnly
+	}
+	return false
+}
+
+func (m *RouteRequest) GetUseNeutrons() bool {
+	if m != nil {
+		return m.UseNeutrons
+	}
+	return false
+}
+
+type RouteWaypoint struct {
+	Name                 string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Coords               *Point3D  `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
+	Distance             float64   `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	PrimaryStar          *StarInfo `protobuf:"bytes,4,opt,name=primary_star,json=primaryStar,proto3" json:"primary_star,omitempty"`
+	Supercharged         bool      `protobuf:"varint,5,opt,name=supercharged,proto3" json:"supercharged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RouteWaypoint) Reset()         { *m = RouteWaypoint{} }
+func (m *RouteWaypoint) String() string { return proto.CompactTextString(m) }
+func (*RouteWaypoint) ProtoMessage()    {}
+func (*RouteWaypoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{32}
+}
+
+func (m *RouteWaypoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteWaypoint.Unmarshal(m, b)
+}
+func (m *RouteWaypoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteWaypoint.Marshal(b, m, deterministic)
+}
+func (m *RouteWaypoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteWaypoint.Merge(m, src)
+}
+func (m *RouteWaypoint) XXX_Size() int {
+	return xxx_messageInfo_RouteWaypoint.Size(m)
+}
+func (m *RouteWaypoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteWaypoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteWaypoint proto.InternalMessageInfo
+
+func (m *RouteWaypoint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RouteWaypoint) GetCoords() *Point3D {
+	if m != nil {
+		return m.Coords
+	}
+	return nil
+}
+
+func (m *RouteWaypoint) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *RouteWaypoint) GetPrimaryStar() *StarInfo {
+	if m != nil {
+		return m.PrimaryStar
+	}
+	return nil
+}
+
+func (m *RouteWaypoint) GetSupercharged() bool {
+	if m != nil {
+		return m.Supercharged
+	}
+	return false
+}
+
+type RouteReply struct {
+	Error                string           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Waypoints            []*RouteWaypoint `protobuf:"bytes,2,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	Jumps                int64            `protobuf:"varint,3,opt,name=jumps,proto3" json:"jumps,omitempty"`
+	TotalDistance        float64          `protobuf:"fixed64,4,opt,name=total_distance,json=totalDistance,proto3" json:"total_distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RouteReply) Reset()         { *m = RouteReply{} }
+func (m *RouteReply) String() string { return proto.CompactTextString(m) }
+func (*RouteReply) ProtoMessage()    {}
+func (*RouteReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{33}
+}
+
+func (m *RouteReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteReply.Unmarshal(m, b)
+}
+func (m *RouteReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteReply.Marshal(b, m, deterministic)
+}
+func (m *RouteReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteReply.Merge(m, src)
+}
+func (m *RouteReply) XXX_Size() int {
+	return xxx_messageInfo_RouteReply.Size(m)
+}
+func (m *RouteReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteReply proto.InternalMessageInfo
+
+func (m *RouteReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *RouteReply) GetWaypoints() []*RouteWaypoint {
+	if m != nil {
+		return m.Waypoints
+	}
+	return nil
+}
+
+func (m *RouteReply) GetJumps() int64 {
+	if m != nil {
+		return m.Jumps
+	}
+	return 0
+}
+
+func (m *RouteReply) GetTotalDistance() float64 {
+	if m != nil {
+		return m.TotalDistance
+	}
+	return 0
+}
+
+type TradeRouteRequest struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Capacity             int64    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	JumpRange            float64  `protobuf:"fixed64,3,opt,name=jump_range,json=jumpRange,proto3" json:"jump_range,omitempty"`
+	MinPad               string   `protobuf:"bytes,4,opt,name=min_pad,json=minPad,proto3" json:"min_pad,omitempty"`
+	MaxMarketAge         int64    `protobuf:"varint,5,opt,name=max_market_age,json=maxMarketAge,proto3" json:"max_market_age,omitempty"`
+	Limit                int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeRouteRequest) Reset()         { *m = TradeRouteRequest{} }
+func (m *TradeRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*TradeRouteRequest) ProtoMessage()    {}
+func (*TradeRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{34}
+}
+
+func (m *TradeRouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeRouteRequest.Unmarshal(m, b)
+}
+func (m *TradeRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeRouteRequest.Marshal(b, m, deterministic)
+}
+func (m *TradeRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeRouteRequest.Merge(m, src)
+}
+func (m *TradeRouteRequest) XXX_Size() int {
+	return xxx_messageInfo_TradeRouteRequest.Size(m)
+}
+func (m *TradeRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeRouteRequest proto.InternalMessageInfo
+
+func (m *TradeRouteRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *TradeRouteRequest) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *TradeRouteRequest) GetJumpRange() float64 {
+	if m != nil {
+		return m.JumpRange
+	}
+	return 0
+}
+
+func (m *TradeRouteRequest) GetMinPad() string {
+	if m != nil {
+		return m.MinPad
+	}
+	return ""
+}
+
+func (m *TradeRouteRequest) GetMaxMarketAge() int64 {
+	if m != nil {
+		return m.MaxMarketAge
+	}
+	return 0
+}
+
+func (m *TradeRouteRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TradeHop struct {
+	Commodity            string   `protobuf:"bytes,1,opt,name=commodity,proto3" json:"commodity,omitempty"`
+	FromStation          string   `protobuf:"bytes,2,opt,name=from_station,json=fromStation,proto3" json:"from_station,omitempty"`
+	FromSystem           string   `protobuf:"bytes,3,opt,name=from_system,json=fromSystem,proto3" json:"from_system,omitempty"`
+	ToStation            string   `protobuf:"bytes,4,opt,name=to_station,json=toStation,proto3" json:"to_station,omitempty"`
+	ToSystem             string   `protobuf:"bytes,5,opt,name=to_system,json=toSystem,proto3" json:"to_system,omitempty"`
+	BuyPrice             int64    `protobuf:"varint,6,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	SellPrice            int64    `protobuf:"varint,7,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	Units                int64    `protobuf:"varint,8,opt,name=units,proto3" json:"units,omitempty"`
+	Profit               int64    `protobuf:"varint,9,opt,name=profit,proto3" json:"profit,omitempty"`
+	Distance             float64  `protobuf:"fixed64,10,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeHop) Reset()         { *m = TradeHop{} }
+func (m *TradeHop) String() string { return proto.CompactTextString(m) }
+func (*TradeHop) ProtoMessage()    {}
+func (*TradeHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{35}
+}
+
+func (m *TradeHop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeHop.Unmarshal(m, b)
+}
+func (m *TradeHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeHop.Marshal(b, m, deterministic)
+}
+func (m *TradeHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeHop.Merge(m, src)
+}
+func (m *TradeHop) XXX_Size() int {
+	return xxx_messageInfo_TradeHop.Size(m)
+}
+func (m *TradeHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeHop proto.InternalMessageInfo
+
+func (m *TradeHop) GetCommodity() string {
+	if m != nil {
+		return m.Commodity
+	}
+	return ""
+}
+
+func (m *TradeHop) GetFromStation() string {
+	if m != nil {
+		return m.FromStation
+	}
+	return ""
+}
+
+func (m *TradeHop) GetFromSystem() string {
+	if m != nil {
+		return m.FromSystem
+	}
+	return ""
+}
+
+func (m *TradeHop) GetToStation() string {
+	if m != nil {
+		return m.ToStation
+	}
+	return ""
+}
+
+func (m *TradeHop) GetToSystem() string {
+	if m != nil {
+		return m.ToSystem
+	}
+	return ""
+}
+
+func (m *TradeHop) GetBuyPrice() int64 {
+	if m != nil {
+		return m.BuyPrice
+	}
+	return 0
+}
+
+func (m *TradeHop) GetSellPrice() int64 {
+	if m != nil {
+		return m.SellPrice
+	}
+	return 0
+}
+
+func (m *TradeHop) GetUnits() int64 {
+	if m != nil {
+		return m.Units
+	}
+	return 0
+}
+
+func (m *TradeHop) GetProfit() int64 {
+	if m != nil {
+		return m.Profit
+	}
+	return 0
+}
+
+func (m *TradeHop) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+type TradeRoundTrip struct {
+	Outbound             *TradeHop `protobuf:"bytes,1,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Inbound              *TradeHop `protobuf:"bytes,2,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	Profit               int64     `protobuf:"varint,3,opt,name=profit,proto3" json:"profit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *TradeRoundTrip) Reset()         { *m = TradeRoundTrip{} }
+func (m *TradeRoundTrip) String() string { return proto.CompactTextString(m) }
+func (*TradeRoundTrip) ProtoMessage()    {}
+func (*TradeRoundTrip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{36}
+}
+
+func (m *TradeRoundTrip) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeRoundTrip.Unmarshal(m, b)
+}
+func (m *TradeRoundTrip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeRoundTrip.Marshal(b, m, deterministic)
+}
+func (m *TradeRoundTrip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeRoundTrip.Merge(m, src)
+}
+func (m *TradeRoundTrip) XXX_Size() int {
+	return xxx_messageInfo_TradeRoundTrip.Size(m)
+}
+func (m *TradeRoundTrip) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeRoundTrip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeRoundTrip proto.InternalMessageInfo
+
+func (m *TradeRoundTrip) GetOutbound() *TradeHop {
+	if m != nil {
+		return m.Outbound
+	}
+	return nil
+}
+
+func (m *TradeRoundTrip) GetInbound() *TradeHop {
+	if m != nil {
+		return m.Inbound
+	}
+	return nil
+}
+
+func (m *TradeRoundTrip) GetProfit() int64 {
+	if m != nil {
+		return m.Profit
+	}
+	return 0
+}
+
+type TradeRouteReply struct {
+	Error                string            `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Hops                 []*TradeHop       `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops,omitempty"`
+	RoundTrips           []*TradeRoundTrip `protobuf:"bytes,3,rep,name=round_trips,json=roundTrips,proto3" json:"round_trips,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TradeRouteReply) Reset()         { *m = TradeRouteReply{} }
+func (m *TradeRouteReply) String() string { return proto.CompactTextString(m) }
+func (*TradeRouteReply) ProtoMessage()    {}
+func (*TradeRouteReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{37}
+}
+
+func (m *TradeRouteReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeRouteReply.Unmarshal(m, b)
+}
+func (m *TradeRouteReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeRouteReply.Marshal(b, m, deterministic)
+}
+func (m *TradeRouteReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeRouteReply.Merge(m, src)
+}
+func (m *TradeRouteReply) XXX_Size() int {
+	return xxx_messageInfo_TradeRouteReply.Size(m)
+}
+func (m *TradeRouteReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeRouteReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeRouteReply proto.InternalMessageInfo
+
+func (m *TradeRouteReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TradeRouteReply) GetHops() []*TradeHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *TradeRouteReply) GetRoundTrips() []*TradeRoundTrip {
+	if m != nil {
+		return m.RoundTrips
+	}
+	return nil
+}
+
+type FindCommodityRequest struct {
+	Commodity            string   `protobuf:"bytes,1,opt,name=commodity,proto3" json:"commodity,omitempty"`
+	Origin               string   `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	MinSupply            int64    `protobuf:"varint,3,opt,name=min_supply,json=minSupply,proto3" json:"min_supply,omitempty"`
+	MinPad               string   `protobuf:"bytes,4,opt,name=min_pad,json=minPad,proto3" json:"min_pad,omitempty"`
+	AllowPlanetary       bool     `protobuf:"varint,5,opt,name=allow_planetary,json=allowPlanetary,proto3" json:"allow_planetary,omitempty"`
+	MaxLocalDistance     float64  `protobuf:"fixed64,6,opt,name=max_local_distance,json=maxLocalDistance,proto3" json:"max_local_distance,omitempty"`
+	MaxDistance          float64  `protobuf:"fixed64,7,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	MaxMarketAge         int64    `protobuf:"varint,8,opt,name=max_market_age,json=maxMarketAge,proto3" json:"max_market_age,omitempty"`
+	Limit                int64    `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindCommodityRequest) Reset()         { *m = FindCommodityRequest{} }
+func (m *FindCommodityRequest) String() string { return proto.CompactTextString(m) }
+func (*FindCommodityRequest) ProtoMessage()    {}
+func (*FindCommodityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{38}
+}
+
+func (m *FindCommodityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindCommodityRequest.Unmarshal(m, b)
+}
+func (m *FindCommodityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindCommodityRequest.Marshal(b, m, deterministic)
+}
+func (m *FindCommodityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindCommodityRequest.Merge(m, src)
+}
+func (m *FindCommodityRequest) XXX_Size() int {
+	return xxx_messageInfo_FindCommodityRequest.Size(m)
+}
+func (m *FindCommodityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindCommodityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindCommodityRequest proto.InternalMessageInfo
+
+func (m *FindCommodityRequest) GetCommodity() string {
+	if m != nil {
+		return m.Commodity
+	}
+	return ""
+}
+
+func (m *FindCommodityRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *FindCommodityRequest) GetMinSupply() int64 {
+	if m != nil {
+		return m.MinSupply
+	}
+	return 0
+}
+
+func (m *FindCommodityRequest) GetMinPad() string {
+	if m != nil {
+		return m.MinPad
+	}
+	return ""
+}
+
+func (m *FindCommodityRequest) GetAllowPlanetary() bool {
+	if m != nil {
+		return m.AllowPlanetary
+	}
+	return false
+}
+
+func (m *FindCommodityRequest) GetMaxLocalDistance() float64 {
+	if m != nil {
+		return m.MaxLocalDistance
+	}
+	return 0
+}
+
+func (m *FindCommodityRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+func (m *FindCommodityRequest) GetMaxMarketAge() int64 {
+	if m != nil {
+		return m.MaxMarketAge
+	}
+	return 0
+}
+
+func (m *FindCommodityRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SuitablePoint struct {
+	Station              string   `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	System               string   `protobuf:"bytes,2,opt,name=system,proto3" json:"system,omitempty"`
+	LandingPad           string   `protobuf:"bytes,3,opt,name=landing_pad,json=landingPad,proto3" json:"landing_pad,omitempty"`
+	Planetary            bool     `protobuf:"varint,4,opt,name=planetary,proto3" json:"planetary,omitempty"`
+	DistanceToStar       float64  `protobuf:"fixed64,5,opt,name=distance_to_star,json=distanceToStar,proto3" json:"distance_to_star,omitempty"`
+	BuyPrice             int64    `protobuf:"varint,6,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	Supply               int64    `protobuf:"varint,7,opt,name=supply,proto3" json:"supply,omitempty"`
+	Distance             float64  `protobuf:"fixed64,8,opt,name=distance,proto3" json:"distance,omitempty"`
+	MarketAge            int64    `protobuf:"varint,9,opt,name=market_age,json=marketAge,proto3" json:"market_age,omitempty"`
+	SellPrice            int64    `protobuf:"varint,10,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	Demand               int64    `protobuf:"varint,11,opt,name=demand,proto3" json:"demand,omitempty"`
+	PriceSource          string   `protobuf:"bytes,12,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SuitablePoint) Reset()         { *m = SuitablePoint{} }
+func (m *SuitablePoint) String() string { return proto.CompactTextString(m) }
+func (*SuitablePoint) ProtoMessage()    {}
+func (*SuitablePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{39}
+}
+
+func (m *SuitablePoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuitablePoint.Unmarshal(m, b)
+}
+func (m *SuitablePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuitablePoint.Marshal(b, m, deterministic)
+}
+func (m *SuitablePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuitablePoint.Merge(m, src)
+}
+func (m *SuitablePoint) XXX_Size() int {
+	return xxx_messageInfo_SuitablePoint.Size(m)
+}
+func (m *SuitablePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuitablePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuitablePoint proto.InternalMessageInfo
+
+func (m *SuitablePoint) GetStation() string {
+	if m != nil {
+		return m.Station
+	}
+	return ""
+}
+
+func (m *SuitablePoint) GetSystem() string {
+	if m != nil {
+		return m.System
+	}
+	return ""
+}
+
+func (m *SuitablePoint) GetLandingPad() string {
+	if m != nil {
+		return m.LandingPad
+	}
+	return ""
+}
+
+func (m *SuitablePoint) GetPlanetary() bool {
+	if m != nil {
+		return m.Planetary
+	}
+	return false
+}
+
+func (m *SuitablePoint) GetDistanceToStar() float64 {
+	if m != nil {
+		return m.DistanceToStar
+	}
+	return 0
+}
+
+func (m *SuitablePoint) GetBuyPrice() int64 {
+	if m != nil {
+		return m.BuyPrice
+	}
+	return 0
+}
+
+func (m *SuitablePoint) GetSupply() int64 {
+	if m != nil {
+		return m.Supply
+	}
+	return 0
+}
+
+func (m *SuitablePoint) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *SuitablePoint) GetMarketAge() int64 {
+	if m != nil {
+		return m.MarketAge
+	}
+	return 0
+}
+
+func (m *SuitablePoint) GetSellPrice() int64 {
+	if m != nil {
+		return m.SellPrice
+	}
+	return 0
+}
+
+func (m *SuitablePoint) GetDemand() int64 {
+	if m != nil {
+		return m.Demand
+	}
+	return 0
+}
+
+func (m *SuitablePoint) GetPriceSource() string {
+	if m != nil {
+		return m.PriceSource
+	}
+	return ""
+}
+
+type SellCommodityRequest struct {
+	Commodity            string   `protobuf:"bytes,1,opt,name=commodity,proto3" json:"commodity,omitempty"`
+	Origin               string   `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Tonnage              int64    `protobuf:"varint,3,opt,name=tonnage,proto3" json:"tonnage,omitempty"`
+	MinPad               string   `protobuf:"bytes,4,opt,name=min_pad,json=minPad,proto3" json:"min_pad,omitempty"`
+	AllowPlanetary       bool     `protobuf:"varint,5,opt,name=allow_planetary,json=allowPlanetary,proto3" json:"allow_planetary,omitempty"`
+	MaxLocalDistance     float64  `protobuf:"fixed64,6,opt,name=max_local_distance,json=maxLocalDistance,proto3" json:"max_local_distance,omitempty"`
+	MaxDistance          float64  `protobuf:"fixed64,7,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	MaxMarketAge         int64    `protobuf:"varint,8,opt,name=max_market_age,json=maxMarketAge,proto3" json:"max_market_age,omitempty"`
+	Limit                int64    `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SellCommodityRequest) Reset()         { *m = SellCommodityRequest{} }
+func (m *SellCommodityRequest) String() string { return proto.CompactTextString(m) }
+func (*SellCommodityRequest) ProtoMessage()    {}
+func (*SellCommodityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{40}
+}
+
+func (m *SellCommodityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SellCommodityRequest.Unmarshal(m, b)
+}
+func (m *SellCommodityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SellCommodityRequest.Marshal(b, m, deterministic)
+}
+func (m *SellCommodityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellCommodityRequest.Merge(m, src)
+}
+func (m *SellCommodityRequest) XXX_Size() int {
+	return xxx_messageInfo_SellCommodityRequest.Size(m)
+}
+func (m *SellCommodityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SellCommodityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SellCommodityRequest proto.InternalMessageInfo
+
+func (m *SellCommodityRequest) GetCommodity() string {
+	if m != nil {
+		return m.Commodity
+	}
+	return ""
+}
+
+func (m *SellCommodityRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *SellCommodityRequest) GetTonnage() int64 {
+	if m != nil {
+		return m.Tonnage
+	}
+	return 0
+}
+
+func (m *SellCommodityRequest) GetMinPad() string {
+	if m != nil {
+		return m.MinPad
+	}
+	return ""
+}
+
+func (m *SellCommodityRequest) GetAllowPlanetary() bool {
+	if m != nil {
+		return m.AllowPlanetary
+	}
+	return false
+}
+
+func (m *SellCommodityRequest) GetMaxLocalDistance() float64 {
+	if m != nil {
+		return m.MaxLocalDistance
+	}
+	return 0
+}
+
+func (m *SellCommodityRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+func (m *SellCommodityRequest) GetMaxMarketAge() int64 {
+	if m != nil {
+		return m.MaxMarketAge
+	}
+	return 0
+}
+
+func (m *SellCommodityRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type FindCommodityReply struct {
+	Error                string           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Points               []*SuitablePoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	SuggestedCommodities []string         `protobuf:"bytes,3,rep,name=suggested_commodities,json=suggestedCommodities,proto3" json:"suggested_commodities,omitempty"`
+	SuggestedSystems     []string         `protobuf:"bytes,4,rep,name=suggested_systems,json=suggestedSystems,proto3" json:"suggested_systems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *FindCommodityReply) Reset()         { *m = FindCommodityReply{} }
+func (m *FindCommodityReply) String() string { return proto.CompactTextString(m) }
+func (*FindCommodityReply) ProtoMessage()    {}
+func (*FindCommodityReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{41}
+}
+
+func (m *FindCommodityReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindCommodityReply.Unmarshal(m, b)
+}
+func (m *FindCommodityReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindCommodityReply.Marshal(b, m, deterministic)
+}
+func (m *FindCommodityReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindCommodityReply.Merge(m, src)
+}
+func (m *FindCommodityReply) XXX_Size() int {
+	return xxx_messageInfo_FindCommodityReply.Size(m)
+}
+func (m *FindCommodityReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindCommodityReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindCommodityReply proto.InternalMessageInfo
+
+func (m *FindCommodityReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FindCommodityReply) GetPoints() []*SuitablePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func (m *FindCommodityReply) GetSuggestedCommodities() []string {
+	if m != nil {
+		return m.SuggestedCommodities
+	}
+	return nil
+}
+
+func (m *FindCommodityReply) GetSuggestedSystems() []string {
+	if m != nil {
+		return m.SuggestedSystems
+	}
+	return nil
+}
+
+type InfluenceHistoryRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Days                 int64    `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InfluenceHistoryRequest) Reset()         { *m = InfluenceHistoryRequest{} }
+func (m *InfluenceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*InfluenceHistoryRequest) ProtoMessage()    {}
+func (*InfluenceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{42}
+}
+
+func (m *InfluenceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfluenceHistoryRequest.Unmarshal(m, b)
+}
+func (m *InfluenceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfluenceHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *InfluenceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfluenceHistoryRequest.Merge(m, src)
+}
+func (m *InfluenceHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_InfluenceHistoryRequest.Size(m)
+}
+func (m *InfluenceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfluenceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfluenceHistoryRequest proto.InternalMessageInfo
+
+func (m *InfluenceHistoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InfluenceHistoryRequest) GetDays() int64 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+type InfluencePoint struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Influence            float64  `protobuf:"fixed64,2,opt,name=influence,proto3" json:"influence,omitempty"`
+	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	PendingStates        []string `protobuf:"bytes,4,rep,name=pending_states,json=pendingStates,proto3" json:"pending_states,omitempty"`
+	RecoveringStates     []string `protobuf:"bytes,5,rep,name=recovering_states,json=recoveringStates,proto3" json:"recovering_states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InfluencePoint) Reset()         { *m = InfluencePoint{} }
+func (m *InfluencePoint) String() string { return proto.CompactTextString(m) }
+func (*InfluencePoint) ProtoMessage()    {}
+func (*InfluencePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{43}
+}
+
+func (m *InfluencePoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfluencePoint.Unmarshal(m, b)
+}
+func (m *InfluencePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfluencePoint.Marshal(b, m, deterministic)
+}
+func (m *InfluencePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfluencePoint.Merge(m, src)
+}
+func (m *InfluencePoint) XXX_Size() int {
+	return xxx_messageInfo_InfluencePoint.Size(m)
+}
+func (m *InfluencePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfluencePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfluencePoint proto.InternalMessageInfo
+
+func (m *InfluencePoint) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *InfluencePoint) GetInfluence() float64 {
+	if m != nil {
+		return m.Influence
+	}
+	return 0
+}
+
+func (m *InfluencePoint) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *InfluencePoint) GetPendingStates() []string {
+	if m != nil {
+		return m.PendingStates
+	}
+	return nil
+}
+
+func (m *InfluencePoint) GetRecoveringStates() []string {
+	if m != nil {
+		return m.RecoveringStates
+	}
+	return nil
+}
+
+type InfluenceSeries struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Points               []*InfluencePoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InfluenceSeries) Reset()         { *m = InfluenceSeries{} }
+func (m *InfluenceSeries) String() string { return proto.CompactTextString(m) }
+func (*InfluenceSeries) ProtoMessage()    {}
+func (*InfluenceSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{44}
+}
+
+func (m *InfluenceSeries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfluenceSeries.Unmarshal(m, b)
+}
+func (m *InfluenceSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfluenceSeries.Marshal(b, m, deterministic)
+}
+func (m *InfluenceSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfluenceSeries.Merge(m, src)
+}
+func (m *InfluenceSeries) XXX_Size() int {
+	return xxx_messageInfo_InfluenceSeries.Size(m)
+}
+func (m *InfluenceSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfluenceSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfluenceSeries proto.InternalMessageInfo
+
+func (m *InfluenceSeries) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InfluenceSeries) GetPoints() []*InfluencePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type InfluenceHistoryReply struct {
+	Error                string             `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Series               []*InfluenceSeries `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *InfluenceHistoryReply) Reset()         { *m = InfluenceHistoryReply{} }
+func (m *InfluenceHistoryReply) String() string { return proto.CompactTextString(m) }
+func (*InfluenceHistoryReply) ProtoMessage()    {}
+func (*InfluenceHistoryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{45}
+}
+
+func (m *InfluenceHistoryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InfluenceHistoryReply.Unmarshal(m, b)
+}
+func (m *InfluenceHistoryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InfluenceHistoryReply.Marshal(b, m, deterministic)
+}
+func (m *InfluenceHistoryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfluenceHistoryReply.Merge(m, src)
+}
+func (m *InfluenceHistoryReply) XXX_Size() int {
+	return xxx_messageInfo_InfluenceHistoryReply.Size(m)
+}
+func (m *InfluenceHistoryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfluenceHistoryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfluenceHistoryReply proto.InternalMessageInfo
+
+func (m *InfluenceHistoryReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *InfluenceHistoryReply) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InfluenceHistoryReply) GetSeries() []*InfluenceSeries {
+	if m != nil {
+		return m.Series
+	}
+	return nil
+}
+
+type FactionByNameRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FactionByNameRequest) Reset()         { *m = FactionByNameRequest{} }
+func (m *FactionByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*FactionByNameRequest) ProtoMessage()    {}
+func (*FactionByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{46}
+}
+
+func (m *FactionByNameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FactionByNameRequest.Unmarshal(m, b)
+}
+func (m *FactionByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FactionByNameRequest.Marshal(b, m, deterministic)
+}
+func (m *FactionByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactionByNameRequest.Merge(m, src)
+}
+func (m *FactionByNameRequest) XXX_Size() int {
+	return xxx_messageInfo_FactionByNameRequest.Size(m)
+}
+func (m *FactionByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactionByNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactionByNameRequest proto.InternalMessageInfo
+
+func (m *FactionByNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type FactionPresence struct {
+	SystemName           string   `protobuf:"bytes,1,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
+	Influence            float64  `protobuf:"fixed64,2,opt,name=influence,proto3" json:"influence,omitempty"`
+	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	IsControlling        bool     `protobuf:"varint,4,opt,name=is_controlling,json=isControlling,proto3" json:"is_controlling,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FactionPresence) Reset()         { *m = FactionPresence{} }
+func (m *FactionPresence) String() string { return proto.CompactTextString(m) }
+func (*FactionPresence) ProtoMessage()    {}
+func (*FactionPresence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{47}
+}
+
+func (m *FactionPresence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FactionPresence.Unmarshal(m, b)
+}
+func (m *FactionPresence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FactionPresence.Marshal(b, m, deterministic)
+}
+func (m *FactionPresence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactionPresence.Merge(m, src)
+}
+func (m *FactionPresence) XXX_Size() int {
+	return xxx_messageInfo_FactionPresence.Size(m)
+}
+func (m *FactionPresence) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactionPresence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactionPresence proto.InternalMessageInfo
+
+func (m *FactionPresence) GetSystemName() string {
+	if m != nil {
+		return m.SystemName
+	}
+	return ""
+}
+
+func (m *FactionPresence) GetInfluence() float64 {
+	if m != nil {
+		return m.Influence
+	}
+	return 0
+}
+
+func (m *FactionPresence) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *FactionPresence) GetIsControlling() bool {
+	if m != nil {
+		return m.IsControlling
+	}
+	return false
+}
+
+type FactionInfo struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Government           string             `protobuf:"bytes,2,opt,name=government,proto3" json:"government,omitempty"`
+	Allegiance           string             `protobuf:"bytes,3,opt,name=allegiance,proto3" json:"allegiance,omitempty"`
+	State                string             `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	HomeSystem           string             `protobuf:"bytes,5,opt,name=home_system,json=homeSystem,proto3" json:"home_system,omitempty"`
+	IsPlayerFaction      bool               `protobuf:"varint,6,opt,name=is_player_faction,json=isPlayerFaction,proto3" json:"is_player_faction,omitempty"`
+	Updated              int64              `protobuf:"varint,7,opt,name=updated,proto3" json:"updated,omitempty"`
+	Presences            []*FactionPresence `protobuf:"bytes,8,rep,name=presences,proto3" json:"presences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *FactionInfo) Reset()         { *m = FactionInfo{} }
+func (m *FactionInfo) String() string { return proto.CompactTextString(m) }
+func (*FactionInfo) ProtoMessage()    {}
+func (*FactionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{48}
+}
+
+func (m *FactionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FactionInfo.Unmarshal(m, b)
+}
+func (m *FactionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FactionInfo.Marshal(b, m, deterministic)
+}
+func (m *FactionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactionInfo.Merge(m, src)
+}
+func (m *FactionInfo) XXX_Size() int {
+	return xxx_messageInfo_FactionInfo.Size(m)
+}
+func (m *FactionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactionInfo proto.InternalMessageInfo
+
+func (m *FactionInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FactionInfo) GetGovernment() string {
+	if m != nil {
+		return m.Government
+	}
+	return ""
+}
+
+func (m *FactionInfo) GetAllegiance() string {
+	if m != nil {
+		return m.Allegiance
+	}
+	return ""
+}
+
+func (m *FactionInfo) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *FactionInfo) GetHomeSystem() string {
+	if m != nil {
+		return m.HomeSystem
+	}
+	return ""
+}
+
+func (m *FactionInfo) GetIsPlayerFaction() bool {
+	if m != nil {
+		return m.IsPlayerFaction
+	}
+	return false
+}
+
+func (m *FactionInfo) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+func (m *FactionInfo) GetPresences() []*FactionPresence {
+	if m != nil {
+		return m.Presences
+	}
+	return nil
+}
+
+type FactionInfoReply struct {
+	Error                string       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Faction              *FactionInfo `protobuf:"bytes,2,opt,name=faction,proto3" json:"faction,omitempty"`
+	SuggestedFactions    []string     `protobuf:"bytes,3,rep,name=suggested_factions,json=suggestedFactions,proto3" json:"suggested_factions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FactionInfoReply) Reset()         { *m = FactionInfoReply{} }
+func (m *FactionInfoReply) String() string { return proto.CompactTextString(m) }
+func (*FactionInfoReply) ProtoMessage()    {}
+func (*FactionInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{49}
+}
+
+func (m *FactionInfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FactionInfoReply.Unmarshal(m, b)
+}
+func (m *FactionInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FactionInfoReply.Marshal(b, m, deterministic)
+}
+func (m *FactionInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactionInfoReply.Merge(m, src)
+}
+func (m *FactionInfoReply) XXX_Size() int {
+	return xxx_messageInfo_FactionInfoReply.Size(m)
+}
+func (m *FactionInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactionInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactionInfoReply proto.InternalMessageInfo
+
+func (m *FactionInfoReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FactionInfoReply) GetFaction() *FactionInfo {
+	if m != nil {
+		return m.Faction
+	}
+	return nil
+}
+
+func (m *FactionInfoReply) GetSuggestedFactions() []string {
+	if m != nil {
+		return m.SuggestedFactions
+	}
+	return nil
+}
+
+type EventsSubscriptionRequest struct {
+	Events               []string `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	SystemName           string   `protobuf:"bytes,2,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
+	Radius               float64  `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Faction              string   `protobuf:"bytes,4,opt,name=faction,proto3" json:"faction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventsSubscriptionRequest) Reset()         { *m = EventsSubscriptionRequest{} }
+func (m *EventsSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsSubscriptionRequest) ProtoMessage()    {}
+func (*EventsSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{50}
+}
+
+func (m *EventsSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsSubscriptionRequest.Unmarshal(m, b)
+}
+func (m *EventsSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsSubscriptionRequest.Marshal(b, m, deterministic)
+}
+func (m *EventsSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsSubscriptionRequest.Merge(m, src)
+}
+func (m *EventsSubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_EventsSubscriptionRequest.Size(m)
+}
+func (m *EventsSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsSubscriptionRequest proto.InternalMessageInfo
+
+func (m *EventsSubscriptionRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *EventsSubscriptionRequest) GetSystemName() string {
+	if m != nil {
+		return m.SystemName
+	}
+	return ""
+}
+
+func (m *EventsSubscriptionRequest) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *EventsSubscriptionRequest) GetFaction() string {
+	if m != nil {
+		return m.Faction
+	}
+	return ""
+}
+
+type EventFactionState struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Allegiance           string   `protobuf:"bytes,2,opt,name=allegiance,proto3" json:"allegiance,omitempty"`
+	Government           string   `protobuf:"bytes,3,opt,name=government,proto3" json:"government,omitempty"`
+	State                string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Influence            float64  `protobuf:"fixed64,5,opt,name=influence,proto3" json:"influence,omitempty"`
+	PendingStates        []string `protobuf:"bytes,6,rep,name=pending_states,json=pendingStates,proto3" json:"pending_states,omitempty"`
+	RecoveringStates     []string `protobuf:"bytes,7,rep,name=recovering_states,json=recoveringStates,proto3" json:"recovering_states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventFactionState) Reset()         { *m = EventFactionState{} }
+func (m *EventFactionState) String() string { return proto.CompactTextString(m) }
+func (*EventFactionState) ProtoMessage()    {}
+func (*EventFactionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{51}
+}
+
+func (m *EventFactionState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventFactionState.Unmarshal(m, b)
+}
+func (m *EventFactionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventFactionState.Marshal(b, m, deterministic)
+}
+func (m *EventFactionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFactionState.Merge(m, src)
+}
+func (m *EventFactionState) XXX_Size() int {
+	return xxx_messageInfo_EventFactionState.Size(m)
+}
+func (m *EventFactionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFactionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFactionState proto.InternalMessageInfo
+
+func (m *EventFactionState) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventFactionState) GetAllegiance() string {
+	if m != nil {
+		return m.Allegiance
+	}
+	return ""
+}
+
+func (m *EventFactionState) GetGovernment() string {
+	if m != nil {
+		return m.Government
+	}
+	return ""
+}
+
+func (m *EventFactionState) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *EventFactionState) GetInfluence() float64 {
+	if m != nil {
+		return m.Influence
+	}
+	return 0
+}
+
+func (m *EventFactionState) GetPendingStates() []string {
+	if m != nil {
+		return m.PendingStates
+	}
+	return nil
+}
+
+func (m *EventFactionState) GetRecoveringStates() []string {
+	if m != nil {
+		return m.RecoveringStates
+	}
+	return nil
+}
+
+type FSDJumpEvent struct {
+	StarSystem           string               `protobuf:"bytes,1,opt,name=star_system,json=starSystem,proto3" json:"star_system,omitempty"`
+	Coords               *Point3D             `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
+	SystemAddress        int64                `protobuf:"varint,3,opt,name=system_address,json=systemAddress,proto3" json:"system_address,omitempty"`
+	Population           int64                `protobuf:"varint,4,opt,name=population,proto3" json:"population,omitempty"`
+	Allegiance           string               `protobuf:"bytes,5,opt,name=allegiance,proto3" json:"allegiance,omitempty"`
+	Economy              string               `protobuf:"bytes,6,opt,name=economy,proto3" json:"economy,omitempty"`
+	Government           string               `protobuf:"bytes,7,opt,name=government,proto3" json:"government,omitempty"`
+	Security             string               `protobuf:"bytes,8,opt,name=security,proto3" json:"security,omitempty"`
+	SystemFaction        string               `protobuf:"bytes,9,opt,name=system_faction,json=systemFaction,proto3" json:"system_faction,omitempty"`
+	Factions             []*EventFactionState `protobuf:"bytes,10,rep,name=factions,proto3" json:"factions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FSDJumpEvent) Reset()         { *m = FSDJumpEvent{} }
+func (m *FSDJumpEvent) String() string { return proto.CompactTextString(m) }
+func (*FSDJumpEvent) ProtoMessage()    {}
+func (*FSDJumpEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{52}
+}
+
+func (m *FSDJumpEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FSDJumpEvent.Unmarshal(m, b)
+}
+func (m *FSDJumpEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FSDJumpEvent.Marshal(b, m, deterministic)
+}
+func (m *FSDJumpEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FSDJumpEvent.Merge(m, src)
+}
+func (m *FSDJumpEvent) XXX_Size() int {
+	return xxx_messageInfo_FSDJumpEvent.Size(m)
+}
+func (m *FSDJumpEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FSDJumpEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FSDJumpEvent proto.InternalMessageInfo
+
+func (m *FSDJumpEvent) GetStarSystem() string {
+	if m != nil {
+		return m.StarSystem
+	}
+	return ""
+}
+
+func (m *FSDJumpEvent) GetCoords() *Point3D {
+	if m != nil {
+		return m.Coords
+	}
+	return nil
+}
+
+func (m *FSDJumpEvent) GetSystemAddress() int64 {
+	if m != nil {
+		return m.SystemAddress
+	}
+	return 0
+}
+
+func (m *FSDJumpEvent) GetPopulation() int64 {
+	if m != nil {
+		return m.Population
+	}
+	return 0
+}
+
+func (m *FSDJumpEvent) GetAllegiance() string {
+	if m != nil {
+		return m.Allegiance
+	}
+	return ""
+}
+
+func (m *FSDJumpEvent) GetEconomy() string {
+	if m != nil {
+		return m.Economy
+	}
+	return ""
+}
+
+func (m *FSDJumpEvent) GetGovernment() string {
+	if m != nil {
+		return m.Government
+	}
+	return ""
+}
+
+func (m *FSDJumpEvent) GetSecurity() string {
+	if m != nil {
+		return m.Security
+	}
+	return ""
+}
+
+func (m *FSDJumpEvent) GetSystemFaction() string {
+	if m != nil {
+		return m.SystemFaction
+	}
+	return ""
+}
+
+func (m *FSDJumpEvent) GetFactions() []*EventFactionState {
+	if m != nil {
+		return m.Factions
+	}
+	return nil
+}
+
+type DockedEvent struct {
+	StarSystem           string   `protobuf:"bytes,1,opt,name=star_system,json=starSystem,proto3" json:"star_system,omitempty"`
+	Coords               *Point3D `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
+	SystemAddress        int64    `protobuf:"varint,3,opt,name=system_address,json=systemAddress,proto3" json:"system_address,omitempty"`
+	StationName          string   `protobuf:"bytes,4,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	StationType          string   `protobuf:"bytes,5,opt,name=station_type,json=stationType,proto3" json:"station_type,omitempty"`
+	MarketId             int64    `protobuf:"varint,6,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	DistFromStarLs       float64  `protobuf:"fixed64,7,opt,name=dist_from_star_ls,json=distFromStarLs,proto3" json:"dist_from_star_ls,omitempty"`
+	StationFaction       string   `protobuf:"bytes,8,opt,name=station_faction,json=stationFaction,proto3" json:"station_faction,omitempty"`
+	StationAllegiance    string   `protobuf:"bytes,9,opt,name=station_allegiance,json=stationAllegiance,proto3" json:"station_allegiance,omitempty"`
+	StationGovernment    string   `protobuf:"bytes,10,opt,name=station_government,json=stationGovernment,proto3" json:"station_government,omitempty"`
+	StationEconomy       string   `protobuf:"bytes,11,opt,name=station_economy,json=stationEconomy,proto3" json:"station_economy,omitempty"`
+	StationServices      []string `protobuf:"bytes,12,rep,name=station_services,json=stationServices,proto3" json:"station_services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DockedEvent) Reset()         { *m = DockedEvent{} }
+func (m *DockedEvent) String() string { return proto.CompactTextString(m) }
+func (*DockedEvent) ProtoMessage()    {}
+func (*DockedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{53}
+}
+
+func (m *DockedEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DockedEvent.Unmarshal(m, b)
+}
+func (m *DockedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DockedEvent.Marshal(b, m, deterministic)
+}
+func (m *DockedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DockedEvent.Merge(m, src)
+}
+func (m *DockedEvent) XXX_Size() int {
+	return xxx_messageInfo_DockedEvent.Size(m)
+}
+func (m *DockedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DockedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DockedEvent proto.InternalMessageInfo
+
+func (m *DockedEvent) GetStarSystem() string {
+	if m != nil {
+		return m.StarSystem
+	}
+	return ""
+}
+
+func (m *DockedEvent) GetCoords() *Point3D {
+	if m != nil {
+		return m.Coords
+	}
+	return nil
+}
+
+func (m *DockedEvent) GetSystemAddress() int64 {
+	if m != nil {
+		return m.SystemAddress
+	}
+	return 0
+}
+
+func (m *DockedEvent) GetStationName() string {
+	if m != nil {
+		return m.StationName
+	}
+	return ""
+}
+
+func (m *DockedEvent) GetStationType() string {
+	if m != nil {
+		return m.StationType
+	}
+	return ""
+}
+
+func (m *DockedEvent) GetMarketId() int64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *DockedEvent) GetDistFromStarLs() float64 {
+	if m != nil {
+		return m.DistFromStarLs
+	}
+	return 0
+}
+
+func (m *DockedEvent) GetStationFaction() string {
+	if m != nil {
+		return m.StationFaction
+	}
+	return ""
+}
+
+func (m *DockedEvent) GetStationAllegiance() string {
+	if m != nil {
+		return m.StationAllegiance
+	}
+	return ""
+}
+
+func (m *DockedEvent) GetStationGovernment() string {
+	if m != nil {
+		return m.StationGovernment
+	}
+	return ""
+}
+
+func (m *DockedEvent) GetStationEconomy() string {
+	if m != nil {
+		return m.StationEconomy
+	}
+	return ""
+}
+
+func (m *DockedEvent) GetStationServices() []string {
+	if m != nil {
+		return m.StationServices
+	}
+	return nil
+}
+
+type EDDNEvent struct {
+	Event                string        `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Timestamp            int64         `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SoftwareName         string        `protobuf:"bytes,3,opt,name=software_name,json=softwareName,proto3" json:"software_name,omitempty"`
+	UploaderId           string        `protobuf:"bytes,4,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
+	Jump                 *FSDJumpEvent `protobuf:"bytes,5,opt,name=jump,proto3" json:"jump,omitempty"`
+	Docked               *DockedEvent  `protobuf:"bytes,6,opt,name=docked,proto3" json:"docked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EDDNEvent) Reset()         { *m = EDDNEvent{} }
+func (m *EDDNEvent) String() string { return proto.CompactTextString(m) }
+func (*EDDNEvent) ProtoMessage()    {}
+func (*EDDNEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{54}
+}
+
+func (m *EDDNEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EDDNEvent.Unmarshal(m, b)
+}
+func (m *EDDNEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EDDNEvent.Marshal(b, m, deterministic)
+}
+func (m *EDDNEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EDDNEvent.Merge(m, src)
+}
+func (m *EDDNEvent) XXX_Size() int {
+	return xxx_messageInfo_EDDNEvent.Size(m)
+}
+func (m *EDDNEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EDDNEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EDDNEvent proto.InternalMessageInfo
+
+func (m *EDDNEvent) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *EDDNEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *EDDNEvent) GetSoftwareName() string {
+	if m != nil {
+		return m.SoftwareName
+	}
+	return ""
+}
+
+func (m *EDDNEvent) GetUploaderId() string {
+	if m != nil {
+		return m.UploaderId
+	}
+	return ""
+}
+
+func (m *EDDNEvent) GetJump() *FSDJumpEvent {
+	if m != nil {
+		return m.Jump
+	}
+	return nil
+}
+
+func (m *EDDNEvent) GetDocked() *DockedEvent {
+	if m != nil {
+		return m.Docked
+	}
+	return nil
+}
+
+type BodyInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BodyId               int64    `protobuf:"varint,2,opt,name=body_id,json=bodyId,proto3" json:"body_id,omitempty"`
+	IsStar               bool     `protobuf:"varint,3,opt,name=is_star,json=isStar,proto3" json:"is_star,omitempty"`
+	Type                 string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	DistanceFromArrival  float64  `protobuf:"fixed64,5,opt,name=distance_from_arrival,json=distanceFromArrival,proto3" json:"distance_from_arrival,omitempty"`
+	Landable             bool     `protobuf:"varint,6,opt,name=landable,proto3" json:"landable,omitempty"`
+	TerraformState       string   `protobuf:"bytes,7,opt,name=terraform_state,json=terraformState,proto3" json:"terraform_state,omitempty"`
+	Atmosphere           string   `protobuf:"bytes,8,opt,name=atmosphere,proto3" json:"atmosphere,omitempty"`
+	RingClasses          []string `protobuf:"bytes,9,rep,name=ring_classes,json=ringClasses,proto3" json:"ring_classes,omitempty"`
+	WasDiscovered        bool     `protobuf:"varint,10,opt,name=was_discovered,json=wasDiscovered,proto3" json:"was_discovered,omitempty"`
+	WasMapped            bool     `protobuf:"varint,11,opt,name=was_mapped,json=wasMapped,proto3" json:"was_mapped,omitempty"`
+	Updated              int64    `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BodyInfo) Reset()         { *m = BodyInfo{} }
+func (m *BodyInfo) String() string { return proto.CompactTextString(m) }
+func (*BodyInfo) ProtoMessage()    {}
+func (*BodyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{55}
+}
+
+func (m *BodyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BodyInfo.Unmarshal(m, b)
+}
+func (m *BodyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BodyInfo.Marshal(b, m, deterministic)
+}
+func (m *BodyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BodyInfo.Merge(m, src)
+}
+func (m *BodyInfo) XXX_Size() int {
+	return xxx_messageInfo_BodyInfo.Size(m)
+}
+func (m *BodyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BodyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BodyInfo proto.InternalMessageInfo
+
+func (m *BodyInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BodyInfo) GetBodyId() int64 {
+	if m != nil {
+		return m.BodyId
+	}
+	return 0
+}
+
+func (m *BodyInfo) GetIsStar() bool {
+	if m != nil {
+		return m.IsStar
+	}
+	return false
+}
+
+func (m *BodyInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BodyInfo) GetDistanceFromArrival() float64 {
+	if m != nil {
+		return m.DistanceFromArrival
+	}
+	return 0
+}
+
+func (m *BodyInfo) GetLandable() bool {
+	if m != nil {
+		return m.Landable
+	}
+	return false
+}
+
+func (m *BodyInfo) GetTerraformState() string {
+	if m != nil {
+		return m.TerraformState
+	}
+	return ""
+}
+
+func (m *BodyInfo) GetAtmosphere() string {
+	if m != nil {
+		return m.Atmosphere
+	}
+	return ""
+}
+
+func (m *BodyInfo) GetRingClasses() []string {
+	if m != nil {
+		return m.RingClasses
+	}
+	return nil
+}
+
+func (m *BodyInfo) GetWasDiscovered() bool {
+	if m != nil {
+		return m.WasDiscovered
+	}
+	return false
+}
+
+func (m *BodyInfo) GetWasMapped() bool {
+	if m != nil {
+		return m.WasMapped
+	}
+	return false
+}
+
+func (m *BodyInfo) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+type SystemBodiesReply struct {
+	Error                string      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Bodies               []*BodyInfo `protobuf:"bytes,2,rep,name=bodies,proto3" json:"bodies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SystemBodiesReply) Reset()         { *m = SystemBodiesReply{} }
+func (m *SystemBodiesReply) String() string { return proto.CompactTextString(m) }
+func (*SystemBodiesReply) ProtoMessage()    {}
+func (*SystemBodiesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{56}
+}
+
+func (m *SystemBodiesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemBodiesReply.Unmarshal(m, b)
+}
+func (m *SystemBodiesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemBodiesReply.Marshal(b, m, deterministic)
+}
+func (m *SystemBodiesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemBodiesReply.Merge(m, src)
+}
+func (m *SystemBodiesReply) XXX_Size() int {
+	return xxx_messageInfo_SystemBodiesReply.Size(m)
+}
+func (m *SystemBodiesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemBodiesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemBodiesReply proto.InternalMessageInfo
+
+func (m *SystemBodiesReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SystemBodiesReply) GetBodies() []*BodyInfo {
+	if m != nil {
+		return m.Bodies
+	}
+	return nil
+}
+
+type CarrierInfo struct {
+	Callsign             string   `protobuf:"bytes,1,opt,name=callsign,proto3" json:"callsign,omitempty"`
+	MarketId             int64    `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	System               string   `protobuf:"bytes,3,opt,name=system,proto3" json:"system,omitempty"`
+	Coords               *Point3D `protobuf:"bytes,4,opt,name=coords,proto3" json:"coords,omitempty"`
+	Services             []string `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`
+	LastSeen             int64    `protobuf:"varint,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Distance             float64  `protobuf:"fixed64,7,opt,name=distance,proto3" json:"distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CarrierInfo) Reset()         { *m = CarrierInfo{} }
+func (m *CarrierInfo) String() string { return proto.CompactTextString(m) }
+func (*CarrierInfo) ProtoMessage()    {}
+func (*CarrierInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{57}
+}
+
+func (m *CarrierInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CarrierInfo.Unmarshal(m, b)
+}
+func (m *CarrierInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CarrierInfo.Marshal(b, m, deterministic)
+}
+func (m *CarrierInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CarrierInfo.Merge(m, src)
+}
+func (m *CarrierInfo) XXX_Size() int {
+	return xxx_messageInfo_CarrierInfo.Size(m)
+}
+func (m *CarrierInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CarrierInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CarrierInfo proto.InternalMessageInfo
+
+func (m *CarrierInfo) GetCallsign() string {
+	if m != nil {
+		return m.Callsign
+	}
+	return ""
+}
+
+func (m *CarrierInfo) GetMarketId() int64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *CarrierInfo) GetSystem() string {
+	if m != nil {
+		return m.System
+	}
+	return ""
+}
+
+func (m *CarrierInfo) GetCoords() *Point3D {
+	if m != nil {
+		return m.Coords
+	}
+	return nil
+}
+
+func (m *CarrierInfo) GetServices() []string {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+func (m *CarrierInfo) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *CarrierInfo) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+type CarrierRequest struct {
+	Callsign             string   `protobuf:"bytes,1,opt,name=callsign,proto3" json:"callsign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CarrierRequest) Reset()         { *m = CarrierRequest{} }
+func (m *CarrierRequest) String() string { return proto.CompactTextString(m) }
+func (*CarrierRequest) ProtoMessage()    {}
+func (*CarrierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{58}
+}
+
+func (m *CarrierRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CarrierRequest.Unmarshal(m, b)
+}
+func (m *CarrierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CarrierRequest.Marshal(b, m, deterministic)
+}
+func (m *CarrierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CarrierRequest.Merge(m, src)
+}
+func (m *CarrierRequest) XXX_Size() int {
+	return xxx_messageInfo_CarrierRequest.Size(m)
+}
+func (m *CarrierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CarrierRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CarrierRequest proto.InternalMessageInfo
+
+func (m *CarrierRequest) GetCallsign() string {
+	if m != nil {
+		return m.Callsign
+	}
+	return ""
+}
+
+type CarrierReply struct {
+	Error                string       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Carrier              *CarrierInfo `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CarrierReply) Reset()         { *m = CarrierReply{} }
+func (m *CarrierReply) String() string { return proto.CompactTextString(m) }
+func (*CarrierReply) ProtoMessage()    {}
+func (*CarrierReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{59}
+}
+
+func (m *CarrierReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CarrierReply.Unmarshal(m, b)
+}
+func (m *CarrierReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CarrierReply.Marshal(b, m, deterministic)
+}
+func (m *CarrierReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CarrierReply.Merge(m, src)
+}
+func (m *CarrierReply) XXX_Size() int {
+	return xxx_messageInfo_CarrierReply.Size(m)
+}
+func (m *CarrierReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CarrierReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CarrierReply proto.InternalMessageInfo
+
+func (m *CarrierReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CarrierReply) GetCarrier() *CarrierInfo {
+	if m != nil {
+		return m.Carrier
+	}
+	return nil
+}
+
+type CarriersNearRequest struct {
+	Origin               string   `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	MaxDistance          float64  `protobuf:"fixed64,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CarriersNearRequest) Reset()         { *m = CarriersNearRequest{} }
+func (m *CarriersNearRequest) String() string { return proto.CompactTextString(m) }
+func (*CarriersNearRequest) ProtoMessage()    {}
+func (*CarriersNearRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{60}
+}
+
+func (m *CarriersNearRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CarriersNearRequest.Unmarshal(m, b)
+}
+func (m *CarriersNearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CarriersNearRequest.Marshal(b, m, deterministic)
+}
+func (m *CarriersNearRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CarriersNearRequest.Merge(m, src)
+}
+func (m *CarriersNearRequest) XXX_Size() int {
+	return xxx_messageInfo_CarriersNearRequest.Size(m)
+}
+func (m *CarriersNearRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CarriersNearRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CarriersNearRequest proto.InternalMessageInfo
+
+func (m *CarriersNearRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *CarriersNearRequest) GetMaxDistance() float64 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+func (m *CarriersNearRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CarriersReply struct {
+	Error                string         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Carriers             []*CarrierInfo `protobuf:"bytes,2,rep,name=carriers,proto3" json:"carriers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CarriersReply) Reset()         { *m = CarriersReply{} }
+func (m *CarriersReply) String() string { return proto.CompactTextString(m) }
+func (*CarriersReply) ProtoMessage()    {}
+func (*CarriersReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4ecdf5e536227fd, []int{61}
+}
+
+func (m *CarriersReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CarriersReply.Unmarshal(m, b)
+}
+func (m *CarriersReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CarriersReply.Marshal(b, m, deterministic)
+}
+func (m *CarriersReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CarriersReply.Merge(m, src)
+}
+func (m *CarriersReply) XXX_Size() int {
+	return xxx_messageInfo_CarriersReply.Size(m)
+}
+func (m *CarriersReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CarriersReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CarriersReply proto.InternalMessageInfo
+
+func (m *CarriersReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CarriersReply) GetCarriers() []*CarrierInfo {
+	if m != nil {
+		return m.Carriers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Point3D)(nil), "api.Point3D")
+	proto.RegisterType((*PopulatedSystemBriefInfo)(nil), "api.PopulatedSystemBriefInfo")
+	proto.RegisterType((*StarInfo)(nil), "api.StarInfo")
+	proto.RegisterType((*SystemSummary)(nil), "api.SystemSummary")
+	proto.RegisterType((*DockableStationShortInfo)(nil), "api.DockableStationShortInfo")
+	proto.RegisterType((*HumanWorldStat)(nil), "api.HumanWorldStat")
+	proto.RegisterType((*ShortFactionState)(nil), "api.ShortFactionState")
+	proto.RegisterType((*InterestingSystem4State)(nil), "api.InterestingSystem4State")
+	proto.RegisterType((*SystemByNameRequest)(nil), "api.SystemByNameRequest")
+	proto.RegisterType((*SystemsDistanceRequest)(nil), "api.SystemsDistanceRequest")
+	proto.RegisterType((*SystemsDistanceReply)(nil), "api.SystemsDistanceReply")
+	proto.RegisterType((*SystemSummaryReply)(nil), "api.SystemSummaryReply")
+	proto.RegisterType((*DockableStationsReply)(nil), "api.DockableStationsReply")
+	proto.RegisterType((*MostVisitedSystemsRequest)(nil), "api.MostVisitedSystemsRequest")
+	proto.RegisterType((*SystemVisitsStat)(nil), "api.SystemVisitsStat")
+	proto.RegisterType((*MostVisitedSystemsReply)(nil), "api.MostVisitedSystemsReply")
+	proto.RegisterType((*InterestingSystem4StateRequest)(nil), "api.InterestingSystem4StateRequest")
+	proto.RegisterType((*InterestingSystem4StateReply)(nil), "api.InterestingSystem4StateReply")
+	proto.RegisterType((*ActivityStatItem)(nil), "api.ActivityStatItem")
+	proto.RegisterType((*ActivityStatRequest)(nil), "api.ActivityStatRequest")
+	proto.RegisterType((*ActivityStatReply)(nil), "api.ActivityStatReply")
+	proto.RegisterType((*StationActivityRequest)(nil), "api.StationActivityRequest")
+	proto.RegisterType((*StationActivity)(nil), "api.StationActivity")
+	proto.RegisterType((*TrendingSystemsRequest)(nil), "api.TrendingSystemsRequest")
+	proto.RegisterType((*TrendingSystem)(nil), "api.TrendingSystem")
+	proto.RegisterType((*TrendingSystemsReply)(nil), "api.TrendingSystemsReply")
+	proto.RegisterType((*UploaderStatRequest)(nil), "api.UploaderStatRequest")
+	proto.RegisterType((*UploaderStatItem)(nil), "api.UploaderStatItem")
+	proto.RegisterType((*SoftwareShare)(nil), "api.SoftwareShare")
+	proto.RegisterType((*UploaderStatReply)(nil), "api.UploaderStatReply")
+	proto.RegisterType((*StationActivityReply)(nil), "api.StationActivityReply")
+	proto.RegisterType((*RouteRequest)(nil), "api.RouteRequest")
+	proto.RegisterType((*RouteWaypoint)(nil), "api.RouteWaypoint")
+	proto.RegisterType((*RouteReply)(nil), "api.RouteReply")
+	proto.RegisterType((*TradeRouteRequest)(nil), "api.TradeRouteRequest")
+	proto.RegisterType((*TradeHop)(nil), "api.TradeHop")
+	proto.RegisterType((*TradeRoundTrip)(nil), "api.TradeRoundTrip")
+	proto.RegisterType((*TradeRouteReply)(nil), "api.TradeRouteReply")
+	proto.RegisterType((*FindCommodityRequest)(nil), "api.FindCommodityRequest")
+	proto.RegisterType((*SuitablePoint)(nil), "api.SuitablePoint")
+	proto.RegisterType((*SellCommodityRequest)(nil), "api.SellCommodityRequest")
+	proto.RegisterType((*FindCommodityReply)(nil), "api.FindCommodityReply")
+	proto.RegisterType((*InfluenceHistoryRequest)(nil), "api.InfluenceHistoryRequest")
+	proto.RegisterType((*InfluencePoint)(nil), "api.InfluencePoint")
+	proto.RegisterType((*InfluenceSeries)(nil), "api.InfluenceSeries")
+	proto.RegisterType((*InfluenceHistoryReply)(nil), "api.InfluenceHistoryReply")
+	proto.RegisterType((*FactionByNameRequest)(nil), "api.FactionByNameRequest")
+	proto.RegisterType((*FactionPresence)(nil), "api.FactionPresence")
+	proto.RegisterType((*FactionInfo)(nil), "api.FactionInfo")
+	proto.RegisterType((*FactionInfoReply)(nil), "api.FactionInfoReply")
+	proto.RegisterType((*EventsSubscriptionRequest)(nil), "api.EventsSubscriptionRequest")
+	proto.RegisterType((*EventFactionState)(nil), "api.EventFactionState")
+	proto.RegisterType((*FSDJumpEvent)(nil), "api.FSDJumpEvent")
+	proto.RegisterType((*DockedEvent)(nil), "api.DockedEvent")
+	proto.RegisterType((*EDDNEvent)(nil), "api.EDDNEvent")
+	proto.RegisterType((*BodyInfo)(nil), "api.BodyInfo")
+	proto.RegisterType((*SystemBodiesReply)(nil), "api.SystemBodiesReply")
+	proto.RegisterType((*CarrierInfo)(nil), "api.CarrierInfo")
+	proto.RegisterType((*CarrierRequest)(nil), "api.CarrierRequest")
+	proto.RegisterType((*CarrierReply)(nil), "api.CarrierReply")
+	proto.RegisterType((*CarriersNearRequest)(nil), "api.CarriersNearRequest")
+	proto.RegisterType((*CarriersReply)(nil), "api.CarriersReply")
+}
+
+func init() { proto.RegisterFile("protobuf-spec/edicenter.proto", fileDescriptor_e4ecdf5e536227fd) }
+
+var fileDescriptor_e4ecdf5e536227fd = []byte{
+	// 3468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0xcb, 0x6e, 0x1c, 0xc7,
+	0xb5, 0xea, 0x19, 0x72, 0x38, 0x73, 0xe6, 0x41, 0x4e, 0x93, 0xa2, 0x46, 0x23, 0xc9, 0x96, 0xda,
+	0x16, 0x2c, 0x59, 0xb2, 0xac, 0x4b, 0x19, 0x06, 0xee, 0xe2, 0x2e, 0x68, 0x51, 0xa2, 0x74, 0xaf,
+	0xa5, 0x4b, 0xf4, 0xc8, 0xb1, 0x81, 0xc0, 0x19, 0x14, 0xa7, 0x8b, 0xc3, 0xb6, 0xbb, 0xbb, 0x3a,
+	0x55, 0x3d, 0x12, 0xc7, 0xc8, 0xc2, 0x41, 0x90, 0x20, 0x5e, 0x64, 0x91, 0x04, 0xf9, 0x86, 0x20,
+	0x40, 0x60, 0x20, 0x8b, 0x04, 0x41, 0xbc, 0xc9, 0x57, 0x04, 0x08, 0x90, 0x65, 0x96, 0x09, 0xb2,
+	0xc9, 0x07, 0x04, 0xa7, 0x1e, 0xfd, 0x9a, 0x07, 0x65, 0x47, 0x4e, 0x80, 0xec, 0xfa, 0x3c, 0xba,
+	0xea, 0xd4, 0x79, 0xd5, 0xa9, 0x53, 0x05, 0x97, 0x62, 0xce, 0x12, 0x76, 0x38, 0x39, 0x7a, 0x43,
+	0xc4, 0x74, 0xf4, 0x26, 0xf5, 0xfc, 0x11, 0x8d, 0x12, 0xca, 0x6f, 0x49, 0xbc, 0x5d, 0x25, 0xb1,
+	0xdf, 0xbf, 0x30, 0x66, 0x6c, 0x1c, 0xd0, 0x37, 0x0d, 0xeb, 0x9b, 0x34, 0x8c, 0x93, 0xa9, 0xe2,
+	0x70, 0xee, 0xc0, 0xda, 0x01, 0xf3, 0xa3, 0xe4, 0xce, 0x9e, 0xdd, 0x02, 0xeb, 0xa4, 0x67, 0x5d,
+	0xb6, 0xae, 0x59, 0xae, 0x75, 0x82, 0xd0, 0xb4, 0x57, 0x51, 0xd0, 0x14, 0xa1, 0x4f, 0x7a, 0x55,
+	0x05, 0x7d, 0xe2, 0x7c, 0x56, 0x81, 0xde, 0x01, 0x8b, 0x27, 0x01, 0x49, 0xa8, 0x37, 0x98, 0x8a,
+	0x84, 0x86, 0xef, 0x70, 0x9f, 0x1e, 0x3d, 0x8c, 0x8e, 0x98, 0xfd, 0x12, 0x00, 0x09, 0x02, 0x3a,
+	0xf6, 0x49, 0x34, 0xa2, 0x72, 0xbc, 0x86, 0x9b, 0xc3, 0x20, 0x7d, 0xcc, 0x9e, 0x52, 0x1e, 0x85,
+	0x34, 0x4a, 0xe4, 0x0c, 0x0d, 0x37, 0x87, 0xb1, 0x7b, 0xb0, 0x76, 0x44, 0x46, 0x89, 0xcf, 0x22,
+	0x39, 0x61, 0xc3, 0x35, 0xa0, 0xfd, 0x0a, 0xb4, 0xf5, 0xe7, 0x50, 0x24, 0x24, 0xa1, 0xbd, 0x15,
+	0x49, 0x6f, 0x69, 0xe4, 0x00, 0x71, 0x38, 0x7c, 0xac, 0x44, 0xc3, 0x11, 0x56, 0x2f, 0x5b, 0xd7,
+	0xaa, 0x6e, 0x0e, 0x83, 0xc3, 0x73, 0x2a, 0x28, 0x7f, 0x4a, 0x7b, 0x35, 0x35, 0xbc, 0x06, 0xed,
+	0x3e, 0xd4, 0x05, 0x1d, 0x4d, 0xb8, 0x9f, 0x4c, 0x7b, 0x6b, 0x92, 0x94, 0xc2, 0xf8, 0x17, 0x1d,
+	0xb1, 0x88, 0x85, 0xd3, 0x5e, 0x5d, 0xfd, 0xa5, 0x41, 0xe7, 0x3d, 0xa8, 0x0f, 0x12, 0xc2, 0xe5,
+	0xd2, 0x6d, 0x58, 0x89, 0x48, 0x68, 0x16, 0x2d, 0xbf, 0x11, 0x97, 0x4c, 0x63, 0xaa, 0x17, 0x2a,
+	0xbf, 0xed, 0x2b, 0xd0, 0xf2, 0xc5, 0x50, 0x8c, 0x18, 0x8b, 0xc9, 0x61, 0x40, 0xe5, 0x3a, 0xeb,
+	0x6e, 0xd3, 0x17, 0x03, 0x83, 0x72, 0xfe, 0x62, 0x41, 0x5b, 0x69, 0x76, 0x30, 0x09, 0x43, 0xc2,
+	0xa7, 0x73, 0x07, 0x7f, 0x15, 0x6a, 0x23, 0xc6, 0xb8, 0x27, 0xe4, 0xf0, 0xcd, 0x9d, 0xd6, 0x2d,
+	0x12, 0xfb, 0xb7, 0xb4, 0x41, 0x5d, 0x4d, 0xb3, 0xef, 0xc1, 0x7a, 0xcc, 0xe2, 0xa1, 0x90, 0xc3,
+	0x0d, 0xfd, 0xe8, 0x88, 0xc9, 0x19, 0x9b, 0x3b, 0x97, 0x34, 0xfb, 0x7c, 0x4b, 0xba, 0xed, 0x98,
+	0xc5, 0x0a, 0x27, 0x57, 0x77, 0x1b, 0x5a, 0x31, 0xf7, 0x51, 0x16, 0x54, 0x3f, 0x97, 0xda, 0x6f,
+	0xee, 0xb4, 0xe5, 0x18, 0x46, 0x05, 0x6e, 0x53, 0xb3, 0x20, 0xc2, 0xbe, 0x06, 0x1b, 0x01, 0x11,
+	0xc9, 0x90, 0x7a, 0x5e, 0x34, 0x9c, 0xc4, 0x1e, 0xda, 0x4c, 0x59, 0xa4, 0x83, 0xf8, 0x7b, 0x9e,
+	0x17, 0xbd, 0x27, 0xb1, 0xce, 0x67, 0x16, 0xf4, 0xf6, 0xd8, 0xe8, 0x63, 0x5c, 0x3b, 0xda, 0x11,
+	0xcd, 0x79, 0xcc, 0x78, 0xb2, 0x50, 0xad, 0x2f, 0x43, 0x33, 0x20, 0x91, 0xe7, 0x47, 0xe3, 0x61,
+	0x4c, 0x3c, 0xe3, 0x46, 0x1a, 0x75, 0x40, 0x3c, 0xb4, 0xa6, 0xe7, 0x8b, 0x44, 0x3a, 0xa1, 0x72,
+	0xdc, 0x14, 0xb6, 0x2f, 0x42, 0x23, 0x0e, 0x48, 0x44, 0x13, 0xc2, 0xa7, 0x72, 0x19, 0x75, 0x37,
+	0x43, 0x38, 0xbf, 0xb0, 0xa0, 0xf3, 0x60, 0x12, 0x92, 0xe8, 0x7d, 0xc6, 0x03, 0x0f, 0xa5, 0x41,
+	0xf3, 0x2b, 0xed, 0x09, 0x29, 0x44, 0xd5, 0x35, 0xa0, 0x74, 0x1a, 0x25, 0xaf, 0xb2, 0x41, 0xd5,
+	0x4d, 0x61, 0xa4, 0x69, 0xd7, 0x14, 0x52, 0x84, 0xaa, 0x9b, 0xc2, 0xf6, 0x55, 0xe8, 0x1c, 0xe3,
+	0x1c, 0xc3, 0x94, 0x63, 0x45, 0x72, 0xb4, 0x25, 0xf6, 0xbe, 0x61, 0x3b, 0xc5, 0x9b, 0x9d, 0x0f,
+	0xa1, 0x2b, 0xf5, 0x74, 0x3f, 0x1f, 0x02, 0xf3, 0xf4, 0xb5, 0x05, 0xab, 0x2a, 0x66, 0x94, 0xa6,
+	0x14, 0x50, 0x8a, 0xd5, 0x6a, 0x39, 0x56, 0x9d, 0x3f, 0x59, 0x70, 0xee, 0x21, 0xe6, 0x13, 0x2a,
+	0x12, 0x3f, 0x1a, 0x2b, 0x67, 0x78, 0x6b, 0xf1, 0x2c, 0xcf, 0xe7, 0x8f, 0xc5, 0x45, 0x55, 0x67,
+	0x42, 0xf4, 0x7f, 0xa0, 0x53, 0x88, 0x73, 0xd4, 0x4d, 0xf5, 0x5a, 0x73, 0x67, 0x5b, 0xb9, 0x5a,
+	0x79, 0xbd, 0x6e, 0x3b, 0x9f, 0x00, 0xc4, 0x97, 0xf0, 0xba, 0xeb, 0xb0, 0xa9, 0x7d, 0x7e, 0xfa,
+	0x98, 0x84, 0xd4, 0xa5, 0xdf, 0x9e, 0x50, 0x91, 0xcc, 0x5b, 0x99, 0xb3, 0x07, 0xdb, 0x8a, 0x55,
+	0xec, 0x69, 0x2f, 0x32, 0xdc, 0x5b, 0xb0, 0x8a, 0x1c, 0xff, 0xa5, 0xd9, 0x15, 0x60, 0xb0, 0x3b,
+	0x46, 0xdf, 0x12, 0x70, 0x1e, 0xc0, 0xd6, 0xcc, 0x28, 0x71, 0x30, 0x45, 0x6e, 0xca, 0x39, 0xe3,
+	0x66, 0x0c, 0x09, 0x14, 0x5c, 0xb8, 0x52, 0x74, 0x61, 0xe7, 0x03, 0xb0, 0x0b, 0xe9, 0x61, 0xd9,
+	0x38, 0x37, 0x61, 0x4d, 0x28, 0x2e, 0x6d, 0x16, 0x5b, 0x29, 0xb2, 0xf0, 0xbf, 0x61, 0x71, 0x7e,
+	0x66, 0xc1, 0xd9, 0x52, 0x28, 0x8a, 0x65, 0xa3, 0xff, 0x77, 0x21, 0x02, 0xaa, 0x69, 0x5a, 0x59,
+	0x14, 0xce, 0xb9, 0x00, 0xb9, 0x01, 0x5d, 0x31, 0x19, 0x8f, 0xa9, 0x48, 0xa8, 0x37, 0x34, 0x01,
+	0x56, 0xbd, 0x5c, 0xbd, 0xd6, 0x70, 0x37, 0x52, 0x82, 0x56, 0x98, 0xf3, 0x7d, 0x0b, 0xce, 0x3f,
+	0x62, 0x22, 0xf9, 0x86, 0x2f, 0xfc, 0x0c, 0x6d, 0xac, 0xb0, 0x0d, 0x35, 0xc6, 0xfd, 0xb1, 0x1f,
+	0x69, 0xe1, 0x34, 0x84, 0xa9, 0x36, 0x24, 0x27, 0xc3, 0x92, 0x1e, 0x9b, 0x21, 0x39, 0x31, 0x16,
+	0xb0, 0xcf, 0xc1, 0x1a, 0xb2, 0x90, 0x31, 0xd5, 0xbe, 0x58, 0x0b, 0xc9, 0xc9, 0xee, 0x58, 0xc6,
+	0x4c, 0xe0, 0x87, 0x7e, 0xa2, 0x43, 0x53, 0x01, 0xce, 0x07, 0xb0, 0xa1, 0xe6, 0x96, 0x82, 0x08,
+	0x99, 0x1f, 0x16, 0x44, 0xdc, 0x88, 0x4d, 0xf4, 0x16, 0x57, 0x75, 0x15, 0xb0, 0x2c, 0x2d, 0x39,
+	0x3f, 0xb5, 0xe0, 0xdc, 0xbc, 0x15, 0x2e, 0xd6, 0xfd, 0x2e, 0x74, 0x75, 0x56, 0x7f, 0x8a, 0xff,
+	0xc8, 0x70, 0xd1, 0x46, 0x38, 0x9b, 0xb3, 0x71, 0x26, 0xa9, 0xbb, 0x2e, 0x32, 0x8c, 0x14, 0xfd,
+	0x65, 0x68, 0x26, 0x2c, 0x21, 0xc1, 0x50, 0x09, 0xab, 0xa3, 0x51, 0xa2, 0xee, 0x22, 0xc6, 0xf9,
+	0xa1, 0x05, 0x2f, 0x2d, 0xc8, 0x01, 0x4b, 0x02, 0x06, 0x0d, 0xa2, 0x83, 0xb7, 0x22, 0x0d, 0xaa,
+	0x21, 0xa9, 0x6d, 0x3f, 0x1a, 0xc6, 0x2c, 0x4e, 0xb5, 0xed, 0x47, 0x07, 0x2c, 0x9e, 0xb1, 0xd4,
+	0xca, 0x8c, 0xa5, 0x9c, 0x00, 0x2e, 0x2e, 0x94, 0x64, 0xb1, 0x92, 0xde, 0xce, 0x92, 0xb7, 0x52,
+	0xcd, 0x45, 0xa9, 0x9a, 0x45, 0x23, 0x19, 0x66, 0xe7, 0x23, 0xd8, 0xd8, 0x1d, 0x25, 0xfe, 0x53,
+	0x3f, 0xc1, 0xdd, 0x2c, 0x79, 0x98, 0xd0, 0x10, 0x77, 0x8e, 0xc4, 0x0f, 0xa9, 0x48, 0x48, 0x18,
+	0xeb, 0xad, 0x20, 0x43, 0xd8, 0x17, 0xa0, 0x11, 0x4d, 0xc2, 0xe1, 0x47, 0x93, 0x30, 0x4e, 0x77,
+	0x83, 0x68, 0x12, 0xfe, 0x2f, 0xc2, 0x86, 0xe8, 0xb1, 0xd1, 0xc7, 0xe9, 0x76, 0x10, 0x4d, 0x42,
+	0x0c, 0x13, 0xe1, 0x1c, 0xc3, 0x66, 0x7e, 0xae, 0x17, 0xe0, 0xd5, 0xdb, 0x50, 0x7b, 0xe6, 0x47,
+	0x1e, 0x7b, 0x66, 0xd4, 0xac, 0x20, 0x67, 0x08, 0xdd, 0xe2, 0x4c, 0x8b, 0x15, 0xf7, 0x16, 0x00,
+	0x1a, 0x6d, 0xe8, 0xe7, 0x74, 0xa7, 0xdc, 0xaa, 0xac, 0x17, 0xb7, 0x21, 0xf4, 0x97, 0x70, 0xbe,
+	0x6b, 0xc1, 0xb6, 0x8e, 0x79, 0xc3, 0xf6, 0xf5, 0x2d, 0x67, 0x41, 0x8c, 0xfe, 0xd2, 0x82, 0xf5,
+	0x92, 0x0c, 0x8b, 0xaa, 0x08, 0x1d, 0x3f, 0x92, 0xa4, 0xab, 0x08, 0x85, 0xc2, 0x1d, 0x61, 0x69,
+	0x15, 0x51, 0x30, 0xe8, 0x4a, 0xd1, 0xa0, 0xf6, 0x0d, 0x58, 0x55, 0x84, 0xd5, 0x65, 0x6a, 0x53,
+	0x3c, 0x52, 0x65, 0x4f, 0x38, 0x8d, 0xbc, 0xd4, 0x17, 0xc5, 0xbf, 0x5c, 0x65, 0x3f, 0xb7, 0xa0,
+	0x53, 0x94, 0x61, 0xae, 0xc6, 0x96, 0xec, 0x49, 0x38, 0xa1, 0x4c, 0x43, 0xc6, 0xbd, 0x35, 0x84,
+	0xe3, 0x70, 0x53, 0xae, 0x5b, 0xae, 0xfc, 0xc6, 0x5a, 0xfe, 0x90, 0x08, 0x1a, 0xf8, 0x11, 0x1d,
+	0x72, 0xb3, 0x43, 0x5b, 0x6e, 0xcb, 0x20, 0x5d, 0x92, 0xa8, 0xa2, 0x65, 0xc4, 0xb8, 0xaa, 0xd4,
+	0x2d, 0x57, 0x01, 0xce, 0x37, 0x61, 0x6b, 0x46, 0x59, 0x8b, 0x9d, 0xf8, 0x8d, 0x72, 0xf4, 0x6f,
+	0x4a, 0x53, 0x14, 0x47, 0xc8, 0x82, 0xfe, 0x18, 0x36, 0xdf, 0x8b, 0x03, 0x46, 0x3c, 0xca, 0xbf,
+	0xe6, 0x40, 0xfc, 0x08, 0x36, 0xf2, 0x33, 0x3d, 0x47, 0x7a, 0xb9, 0x08, 0x8d, 0x89, 0xfe, 0xc3,
+	0xa4, 0x97, 0x0c, 0x81, 0x96, 0x09, 0xa9, 0x10, 0x64, 0x4c, 0xd3, 0xf4, 0x62, 0x60, 0xe7, 0x43,
+	0x68, 0x0f, 0xd8, 0x51, 0xf2, 0x8c, 0x70, 0x3a, 0x38, 0x26, 0x7c, 0x7e, 0xf1, 0xf6, 0xd5, 0x87,
+	0xff, 0xbd, 0x05, 0xdd, 0xa2, 0xd6, 0x16, 0xdb, 0xe3, 0x2b, 0xcf, 0x82, 0x21, 0x75, 0xcc, 0x26,
+	0xdc, 0x54, 0x83, 0x2a, 0xa4, 0xca, 0x2a, 0x74, 0x15, 0x8f, 0x7d, 0x0b, 0xea, 0x42, 0xaf, 0x58,
+	0x87, 0xa0, 0x2e, 0x7a, 0xf2, 0x6a, 0x70, 0x53, 0x1e, 0xe7, 0x5b, 0xb0, 0x35, 0x93, 0xb4, 0x16,
+	0x2f, 0xe2, 0xf6, 0x4c, 0xcd, 0xb3, 0x65, 0x8e, 0x41, 0x85, 0x21, 0x52, 0x2e, 0xe7, 0x57, 0x16,
+	0xb4, 0x5c, 0x36, 0x49, 0xe8, 0x69, 0x1e, 0x75, 0x19, 0x9a, 0x9e, 0xdc, 0x95, 0xe4, 0x8f, 0x3a,
+	0x25, 0xe5, 0x51, 0xf6, 0x25, 0x00, 0xdc, 0x61, 0x86, 0x9c, 0x44, 0x63, 0x93, 0x95, 0x1a, 0x88,
+	0x71, 0x11, 0x81, 0x27, 0x8b, 0xf4, 0x64, 0x39, 0x64, 0x51, 0x60, 0x4e, 0x38, 0xed, 0x14, 0xfb,
+	0xff, 0x51, 0x30, 0x45, 0xcf, 0x9d, 0x08, 0x3a, 0x8c, 0xe8, 0x24, 0xe1, 0xb8, 0x8c, 0x55, 0x75,
+	0x06, 0x9d, 0x08, 0xfa, 0x58, 0xa3, 0x9c, 0x2f, 0x2c, 0x68, 0x4b, 0x99, 0xdf, 0x27, 0xd3, 0x18,
+	0x6b, 0xf8, 0x7f, 0xa2, 0xe6, 0x5f, 0x96, 0x48, 0xbf, 0xfc, 0xc1, 0xd2, 0x81, 0x96, 0x98, 0xc4,
+	0x94, 0x8f, 0x8e, 0x09, 0x1f, 0x53, 0x4f, 0x0b, 0x5f, 0xc0, 0x39, 0x3f, 0xb6, 0x00, 0xb4, 0xc6,
+	0x97, 0x19, 0xb2, 0xf1, 0x4c, 0x2f, 0xce, 0x58, 0x52, 0xf9, 0x49, 0x61, 0xdd, 0x6e, 0xc6, 0x84,
+	0xe3, 0xa8, 0xfd, 0x5d, 0xb9, 0xa7, 0x02, 0x50, 0xe9, 0xaa, 0x8a, 0x2a, 0x95, 0x2f, 0x6d, 0x89,
+	0x4d, 0x0b, 0x98, 0x2f, 0x2c, 0xe8, 0x3e, 0xe1, 0xc4, 0xa3, 0xcf, 0xe5, 0x0a, 0x7d, 0xa8, 0x8f,
+	0x48, 0x4c, 0x46, 0xd8, 0x90, 0xd0, 0xd5, 0x84, 0x81, 0x4f, 0x73, 0x02, 0x53, 0x65, 0x11, 0x4f,
+	0x37, 0x49, 0x64, 0x95, 0x45, 0x3c, 0xfb, 0x55, 0xe8, 0x60, 0xc2, 0x0a, 0x09, 0xff, 0x98, 0x26,
+	0xb2, 0xe6, 0x55, 0x47, 0x23, 0x4c, 0x63, 0x8f, 0x24, 0xb2, 0x50, 0xf9, 0xd6, 0xf2, 0x5b, 0xc4,
+	0xe7, 0x15, 0xa8, 0x4b, 0xe9, 0x1f, 0x30, 0x99, 0x8c, 0x46, 0x2c, 0x0c, 0x99, 0x87, 0xd2, 0x29,
+	0xb9, 0x33, 0x04, 0x7a, 0xd7, 0x11, 0x67, 0xe1, 0x50, 0xfb, 0xbf, 0x71, 0x63, 0xc4, 0xe9, 0x18,
+	0xc1, 0xbd, 0x57, 0xb1, 0xc8, 0xcc, 0x6b, 0x0e, 0x9f, 0x92, 0x43, 0x62, 0x70, 0x89, 0x09, 0x4b,
+	0x47, 0x50, 0xcb, 0x68, 0x24, 0xcc, 0xfc, 0x7f, 0x01, 0x1a, 0x48, 0x56, 0x7f, 0xaf, 0x4a, 0x6a,
+	0x3d, 0x61, 0xfa, 0xdf, 0x0b, 0xd0, 0x38, 0x9c, 0x4c, 0x87, 0x31, 0xf7, 0x47, 0x54, 0x2f, 0xa2,
+	0x7e, 0x38, 0x99, 0x1e, 0x20, 0x8c, 0x03, 0x0b, 0x1a, 0x04, 0x9a, 0xba, 0x26, 0xa9, 0x0d, 0xc4,
+	0x28, 0xf2, 0x16, 0xac, 0x4e, 0x22, 0xdc, 0xc5, 0xea, 0x6a, 0xf1, 0x12, 0x40, 0x23, 0xc5, 0x9c,
+	0x1d, 0xf9, 0x49, 0xaf, 0xa1, 0xd2, 0xb8, 0x82, 0x0a, 0x8e, 0x0d, 0xa5, 0x82, 0xfe, 0x3b, 0xd0,
+	0x31, 0xd6, 0x8e, 0xbc, 0x27, 0xdc, 0x8f, 0xed, 0xeb, 0x50, 0x67, 0x93, 0xe4, 0x10, 0xe1, 0x9e,
+	0x95, 0x73, 0x73, 0xa3, 0x56, 0x37, 0x25, 0xdb, 0xaf, 0xc1, 0x9a, 0x1f, 0x29, 0xce, 0xca, 0x3c,
+	0x4e, 0x43, 0xcd, 0x49, 0x56, 0xcd, 0x4b, 0xe6, 0x7c, 0x6a, 0xc1, 0x7a, 0xde, 0xd9, 0x16, 0x47,
+	0xc1, 0x15, 0x58, 0x39, 0x66, 0xb1, 0x09, 0x80, 0xd2, 0x3c, 0x92, 0x64, 0xbf, 0x05, 0x4d, 0x8e,
+	0xb3, 0x0d, 0x13, 0xee, 0xc7, 0xea, 0x90, 0x96, 0x6d, 0xa5, 0xf9, 0x25, 0xba, 0xc0, 0xcd, 0xa7,
+	0x70, 0x7e, 0x57, 0x81, 0xad, 0xfb, 0x7e, 0xe4, 0xdd, 0x35, 0x8e, 0x61, 0x5c, 0x7e, 0xb9, 0xf7,
+	0x64, 0x01, 0x51, 0x29, 0x04, 0xc4, 0x25, 0x00, 0xf4, 0x6a, 0x31, 0x89, 0xe3, 0x60, 0xaa, 0x57,
+	0xdb, 0x08, 0xfd, 0x68, 0x20, 0x11, 0x8b, 0x9d, 0xfe, 0x35, 0x58, 0x27, 0x41, 0xc0, 0x9e, 0x0d,
+	0xb3, 0xae, 0x8f, 0xca, 0x18, 0x1d, 0x89, 0x3e, 0x30, 0x58, 0xfb, 0x26, 0xd8, 0x18, 0x1d, 0x01,
+	0x1b, 0xe5, 0x43, 0x59, 0x55, 0x1f, 0x1b, 0x21, 0x39, 0x79, 0x97, 0x8d, 0xb2, 0x68, 0x9e, 0xd9,
+	0xfc, 0xd7, 0x66, 0x37, 0xff, 0xd9, 0x70, 0xab, 0x2f, 0x0b, 0xb7, 0x46, 0x3e, 0xdc, 0xfe, 0x5e,
+	0x81, 0xf6, 0x60, 0xe2, 0x27, 0x98, 0xb2, 0x65, 0x3a, 0x95, 0x6d, 0x28, 0x1d, 0x0e, 0x4a, 0x67,
+	0x06, 0x94, 0xa7, 0x2d, 0x15, 0x09, 0x5a, 0x63, 0x0a, 0x2a, 0xb7, 0xc9, 0xaa, 0x33, 0x6d, 0xb2,
+	0xa5, 0xad, 0x30, 0x6c, 0xa5, 0x98, 0xd5, 0x0d, 0x55, 0x2c, 0x72, 0x5d, 0xa8, 0x75, 0x0c, 0xfe,
+	0x09, 0x93, 0x19, 0x79, 0x69, 0xc0, 0xa1, 0x74, 0xca, 0x66, 0x2a, 0xd8, 0x34, 0x54, 0x88, 0x9d,
+	0x7a, 0x69, 0x53, 0x40, 0x5b, 0x67, 0x5a, 0x6b, 0x68, 0x5b, 0xa7, 0x2a, 0x2b, 0xc6, 0x30, 0x94,
+	0x63, 0x78, 0x1b, 0x6a, 0x1e, 0x0d, 0x49, 0xe4, 0xf5, 0x9a, 0x6a, 0x46, 0x05, 0xa1, 0xc9, 0xe4,
+	0x1f, 0x43, 0xc1, 0x26, 0x7c, 0x44, 0x7b, 0x2d, 0x95, 0x97, 0x24, 0x6e, 0x20, 0x51, 0xce, 0xaf,
+	0x2b, 0xb0, 0x35, 0xa0, 0x41, 0xf0, 0x82, 0x7c, 0xb6, 0x07, 0x6b, 0x09, 0x8b, 0xa2, 0xac, 0xbb,
+	0x60, 0xc0, 0xff, 0x34, 0x77, 0xfd, 0xad, 0x05, 0x76, 0x29, 0xd6, 0x17, 0x67, 0x9c, 0xd7, 0xa1,
+	0x36, 0x67, 0xd3, 0x2d, 0x78, 0xbb, 0xab, 0x39, 0xec, 0x3b, 0x70, 0x36, 0xeb, 0x12, 0x19, 0x85,
+	0xfb, 0xd4, 0x74, 0x8a, 0xb6, 0x52, 0xe2, 0xdd, 0x8c, 0x36, 0xbf, 0xb5, 0xb4, 0xb2, 0xa0, 0xb5,
+	0xb4, 0x8b, 0x5d, 0xce, 0xa3, 0x60, 0x42, 0xa3, 0x11, 0x7d, 0xe0, 0x8b, 0x84, 0xf1, 0xe9, 0xb2,
+	0xd6, 0x86, 0x0d, 0x2b, 0x1e, 0x99, 0x9a, 0xea, 0x55, 0x7e, 0x3b, 0xbf, 0xb1, 0xa0, 0x93, 0x8e,
+	0xa1, 0xa2, 0xf5, 0xd4, 0x62, 0xde, 0x37, 0xfc, 0xfa, 0xd8, 0x90, 0x21, 0xb2, 0x76, 0x6d, 0x35,
+	0xdf, 0xae, 0xbd, 0x0a, 0x9d, 0x58, 0x1d, 0x5b, 0xf2, 0x8d, 0xd1, 0x86, 0xdb, 0xd6, 0x58, 0xdd,
+	0x00, 0xbd, 0x01, 0x5d, 0x4e, 0x47, 0x78, 0xa3, 0x92, 0xe3, 0x5c, 0x55, 0x6b, 0xcf, 0x08, 0x8a,
+	0xd9, 0x71, 0x61, 0x3d, 0x95, 0x7b, 0x40, 0x39, 0xea, 0x6e, 0xde, 0x9a, 0x6f, 0x94, 0x0c, 0xb6,
+	0xa9, 0x7b, 0x28, 0xf9, 0x15, 0x1b, 0x8b, 0x39, 0x0c, 0xce, 0xce, 0xea, 0x73, 0xb1, 0x33, 0x98,
+	0xf9, 0x2a, 0xb9, 0xf9, 0x6e, 0x42, 0x4d, 0x50, 0x6e, 0xac, 0x6c, 0xea, 0xeb, 0x92, 0xa4, 0xae,
+	0xe6, 0x71, 0x5e, 0x87, 0x2d, 0xdd, 0x11, 0x3e, 0xbd, 0x93, 0xfb, 0x23, 0x0b, 0xd6, 0x35, 0xf3,
+	0x01, 0xa7, 0x42, 0xaa, 0xbb, 0xd4, 0x07, 0xb0, 0x66, 0xfa, 0x00, 0x5f, 0xd1, 0x5a, 0xbe, 0x18,
+	0x8e, 0x58, 0x94, 0x70, 0x16, 0x04, 0x7e, 0x34, 0x36, 0x85, 0xb8, 0x2f, 0xee, 0x66, 0x48, 0xe7,
+	0x27, 0x15, 0x68, 0x6a, 0x79, 0x16, 0xde, 0x76, 0x9c, 0x76, 0x67, 0x76, 0x4a, 0x1f, 0x3f, 0x13,
+	0x70, 0x25, 0x2f, 0xe0, 0xcb, 0xd0, 0x3c, 0x66, 0x21, 0x2d, 0xd6, 0x50, 0x80, 0x28, 0x5d, 0x45,
+	0xbd, 0x0e, 0x5d, 0x5f, 0x60, 0x16, 0x9a, 0x52, 0x6e, 0x2e, 0x2a, 0x64, 0x7a, 0xa9, 0xbb, 0xeb,
+	0xbe, 0x38, 0x90, 0x78, 0x2d, 0x3a, 0xe6, 0x39, 0xd5, 0x6b, 0xf7, 0x74, 0x92, 0x37, 0xa0, 0xbd,
+	0x03, 0x8d, 0x58, 0x2b, 0x1a, 0x6b, 0xaa, 0xcc, 0x9a, 0x25, 0x2b, 0xb8, 0x19, 0x9b, 0xf3, 0x3d,
+	0x0b, 0x36, 0x72, 0x4a, 0x59, 0x9e, 0x4a, 0xd2, 0xfb, 0x42, 0x55, 0x27, 0x6d, 0xe4, 0x07, 0x97,
+	0x7f, 0x1b, 0x06, 0xfb, 0x0d, 0xb0, 0xb3, 0xac, 0x90, 0xbb, 0x9b, 0xc1, 0xd0, 0xc8, 0xf2, 0x85,
+	0xfe, 0x55, 0x38, 0x3f, 0xb0, 0xe0, 0xfc, 0xbd, 0xa7, 0x34, 0x4a, 0xc4, 0x60, 0x72, 0x28, 0x46,
+	0xdc, 0x8f, 0x11, 0x9f, 0x2b, 0xdb, 0xa9, 0x24, 0xf6, 0x2c, 0xd5, 0xe1, 0x54, 0xd0, 0xe9, 0x4d,
+	0xa5, 0x6d, 0xa8, 0x71, 0xe2, 0xf9, 0x13, 0xa1, 0xeb, 0x76, 0x0d, 0xe5, 0x6f, 0x3e, 0x57, 0x0a,
+	0x37, 0x9f, 0xce, 0xdf, 0x2c, 0xe8, 0x4a, 0x41, 0x4e, 0xbd, 0xe7, 0x29, 0x7a, 0x42, 0xe5, 0x94,
+	0xdb, 0xd7, 0xea, 0x8c, 0x27, 0xcd, 0xf7, 0x94, 0x82, 0xfb, 0xaf, 0x96, 0xdd, 0x7f, 0x36, 0x2d,
+	0xd5, 0x9e, 0x3b, 0x2d, 0xad, 0x2d, 0x48, 0x4b, 0x7f, 0xad, 0x40, 0xeb, 0xfe, 0x60, 0x0f, 0x5b,
+	0xa7, 0x72, 0xe1, 0x52, 0xab, 0x09, 0xe1, 0xc6, 0x59, 0x4d, 0x88, 0x26, 0x84, 0x6b, 0x67, 0x7d,
+	0xbe, 0x73, 0x28, 0x9e, 0x8e, 0x95, 0x71, 0x88, 0xe7, 0x71, 0x2a, 0xcc, 0x39, 0xae, 0xad, 0xb0,
+	0xbb, 0x0a, 0x59, 0xba, 0xa2, 0x5a, 0x99, 0xb9, 0xa2, 0x2a, 0xaa, 0x79, 0x75, 0x46, 0xcd, 0xb9,
+	0xfb, 0xe2, 0x5a, 0xe1, 0xbe, 0xb8, 0x64, 0x80, 0xb5, 0x19, 0x03, 0xe4, 0x6f, 0xa1, 0xeb, 0xa5,
+	0x5b, 0xe8, 0x4c, 0x78, 0xe3, 0x27, 0x0d, 0xc9, 0xa1, 0x85, 0x37, 0xa1, 0xb8, 0x93, 0xbb, 0x77,
+	0x84, 0xdc, 0xcd, 0xd9, 0x8c, 0x07, 0x65, 0xf7, 0x91, 0xce, 0x9f, 0xab, 0xd0, 0xc4, 0xce, 0x25,
+	0xf5, 0xfe, 0x1d, 0xea, 0xbe, 0x02, 0x2d, 0x5d, 0xc9, 0xaa, 0x98, 0x51, 0xce, 0xd7, 0xd4, 0x38,
+	0x19, 0x34, 0x39, 0x16, 0x79, 0x9f, 0xbe, 0x5a, 0x60, 0x79, 0x82, 0xd7, 0xea, 0x17, 0x40, 0x17,
+	0x88, 0x43, 0xdf, 0x33, 0x35, 0xa8, 0x42, 0x3c, 0xf4, 0xec, 0xeb, 0xd0, 0xc5, 0xca, 0x67, 0x68,
+	0x8e, 0xa5, 0x7c, 0x18, 0x88, 0xde, 0x5a, 0x56, 0xcb, 0xde, 0x57, 0x47, 0x53, 0xfe, 0xae, 0xc0,
+	0xfa, 0xcb, 0x4c, 0x65, 0xf4, 0xac, 0x2c, 0xd1, 0xd1, 0xe8, 0xfb, 0xb9, 0x74, 0xa2, 0x19, 0x73,
+	0xde, 0xa0, 0x6c, 0xd2, 0xd5, 0x94, 0xdd, 0x94, 0x90, 0x67, 0xcf, 0xb9, 0x00, 0x14, 0xd8, 0xf7,
+	0x53, 0x42, 0x5e, 0x0c, 0xe3, 0x4b, 0xcd, 0x82, 0x18, 0xf7, 0x14, 0xd6, 0xbe, 0x0e, 0x1b, 0x86,
+	0x11, 0x5f, 0x32, 0xf8, 0x98, 0x67, 0x5b, 0x32, 0xae, 0xcc, 0x00, 0x03, 0x8d, 0x76, 0xfe, 0x60,
+	0x41, 0xe3, 0xde, 0xde, 0xde, 0x63, 0x65, 0x64, 0x4c, 0xa8, 0xf8, 0x91, 0x26, 0xd4, 0xa7, 0xb4,
+	0x5c, 0xb7, 0x54, 0xca, 0x75, 0xcb, 0x2b, 0xd0, 0x36, 0x4d, 0x33, 0x65, 0x2b, 0x95, 0x43, 0x5a,
+	0x06, 0xf9, 0x58, 0xf7, 0xd5, 0x4d, 0x4f, 0x0f, 0x6d, 0xa1, 0xcc, 0x09, 0x06, 0xf5, 0xd0, 0xb3,
+	0xaf, 0xc2, 0x0a, 0x36, 0x2b, 0xa4, 0x15, 0x9b, 0x3b, 0x5d, 0x95, 0xb1, 0x73, 0xe1, 0xee, 0x4a,
+	0xb2, 0x7d, 0x0d, 0x6a, 0x9e, 0x74, 0xca, 0x5e, 0x2d, 0x97, 0xda, 0x73, 0x7e, 0xea, 0x6a, 0xba,
+	0xf3, 0x69, 0x15, 0xea, 0xef, 0x30, 0x6f, 0xba, 0x70, 0x0b, 0x3d, 0x07, 0x6b, 0x87, 0xcc, 0x9b,
+	0xa2, 0x38, 0x6a, 0x4d, 0x35, 0x04, 0x1f, 0x7a, 0x48, 0xf0, 0x85, 0x3a, 0xda, 0xa8, 0x77, 0x18,
+	0x35, 0x5f, 0xc8, 0x23, 0x8d, 0x79, 0xb9, 0xb1, 0x92, 0x7b, 0xb9, 0xb1, 0x03, 0x67, 0xd3, 0x03,
+	0x91, 0xf4, 0x24, 0xc2, 0xb9, 0xff, 0x94, 0x04, 0x3a, 0x29, 0x6e, 0x1a, 0x22, 0x7a, 0xd3, 0xae,
+	0x22, 0x61, 0x44, 0xe3, 0x81, 0x4b, 0xbe, 0xf4, 0x50, 0x9b, 0x67, 0x0a, 0xa3, 0x8d, 0x13, 0xca,
+	0x39, 0x39, 0x62, 0x3c, 0xd4, 0x8f, 0x5a, 0x54, 0x4a, 0xe8, 0xa4, 0xe8, 0xf4, 0x59, 0x0b, 0x49,
+	0x42, 0x26, 0xe2, 0x63, 0xca, 0xa9, 0x76, 0xc7, 0x1c, 0x06, 0xc3, 0x43, 0xa6, 0xd5, 0x51, 0x40,
+	0x84, 0xa0, 0xa2, 0xd7, 0x90, 0xf6, 0x6f, 0x22, 0xee, 0xae, 0x42, 0x61, 0x2c, 0x3e, 0x23, 0x02,
+	0xeb, 0x7f, 0x99, 0x6b, 0xa9, 0x27, 0x5d, 0xaf, 0xee, 0xb6, 0x9f, 0x11, 0xb1, 0x97, 0x22, 0xf1,
+	0x64, 0x85, 0x6c, 0x21, 0x89, 0x63, 0xaa, 0x8e, 0x4f, 0x75, 0xec, 0x7f, 0x89, 0x47, 0x12, 0x91,
+	0xdf, 0xe7, 0x5b, 0x85, 0x7d, 0xde, 0x39, 0x80, 0xae, 0xbe, 0x4d, 0x67, 0x9e, 0x4f, 0x97, 0x36,
+	0xe5, 0xaf, 0x02, 0x6a, 0xdf, 0xa7, 0xc5, 0x96, 0x83, 0xb1, 0x9f, 0xab, 0x89, 0xce, 0x1f, 0x2d,
+	0x68, 0xde, 0x45, 0x0d, 0x53, 0xf5, 0xbe, 0x46, 0x36, 0xc4, 0x82, 0x40, 0xf8, 0x63, 0x73, 0x00,
+	0x4e, 0xe1, 0x62, 0xf0, 0x57, 0x4a, 0xc1, 0x9f, 0x1d, 0x8f, 0xab, 0x85, 0xe3, 0x71, 0x96, 0xc4,
+	0x56, 0x96, 0xf7, 0x2e, 0xd3, 0xb8, 0x52, 0x65, 0x74, 0x0a, 0xe3, 0xb4, 0xf2, 0xb1, 0x81, 0xa0,
+	0x34, 0x32, 0x39, 0x07, 0x11, 0x03, 0x4a, 0xa3, 0xc2, 0xf9, 0x76, 0xad, 0xd4, 0x1b, 0xba, 0x09,
+	0x1d, 0xbd, 0x34, 0x53, 0x4f, 0x2c, 0x59, 0x9d, 0x73, 0x00, 0xad, 0x94, 0x7b, 0x69, 0x29, 0x34,
+	0x52, 0x5c, 0x85, 0x52, 0x28, 0xa7, 0x42, 0xd7, 0x30, 0x38, 0x47, 0xb0, 0xa9, 0xf1, 0xe2, 0x31,
+	0x25, 0xfc, 0x05, 0x5c, 0x74, 0xa4, 0xc7, 0xc2, 0x6a, 0xfe, 0x58, 0x38, 0x80, 0xb6, 0x99, 0x67,
+	0xf9, 0x1b, 0x85, 0xba, 0x96, 0xcc, 0xf8, 0xc4, 0xac, 0xec, 0x29, 0xc7, 0xce, 0xe7, 0x2d, 0x68,
+	0xdd, 0xdb, 0x43, 0xe4, 0x5d, 0xf9, 0xdc, 0xcd, 0xde, 0x87, 0xe6, 0x3e, 0x4d, 0x52, 0x51, 0x2e,
+	0xe4, 0x2e, 0xbf, 0xcb, 0x0f, 0x36, 0xfa, 0xe7, 0xe7, 0x13, 0xe3, 0x60, 0xea, 0x9c, 0xb1, 0xf7,
+	0x61, 0x63, 0x9f, 0x26, 0xc5, 0x97, 0x57, 0xbd, 0xdc, 0x0f, 0x85, 0xf3, 0x45, 0xff, 0xdc, 0x9c,
+	0x87, 0x14, 0x7a, 0xa0, 0x47, 0xb0, 0x89, 0x12, 0x95, 0x1e, 0x52, 0x2c, 0x19, 0xab, 0x3f, 0xef,
+	0xd5, 0x84, 0x30, 0xc3, 0xbd, 0x0f, 0x67, 0xf7, 0x69, 0x32, 0xfb, 0x3a, 0xc0, 0x7e, 0x49, 0xfe,
+	0xb6, 0xf0, 0x61, 0x44, 0xff, 0xe2, 0x42, 0xba, 0x1a, 0xf8, 0x08, 0xfa, 0xfb, 0x34, 0x59, 0xf4,
+	0xc8, 0xe7, 0x95, 0xa5, 0x57, 0xe5, 0x7a, 0x8a, 0x2b, 0xcb, 0x99, 0xd4, 0x3c, 0xef, 0x40, 0x77,
+	0x9f, 0x26, 0xa5, 0x77, 0x55, 0xdb, 0xb7, 0xd4, 0xdb, 0xc4, 0x5b, 0xe6, 0x6d, 0xe2, 0xad, 0x7b,
+	0xf8, 0x36, 0xb1, 0xaf, 0x4e, 0x97, 0x45, 0x66, 0xe7, 0x8c, 0xfd, 0x7f, 0x52, 0x09, 0xfb, 0x24,
+	0x20, 0x27, 0xd3, 0xfc, 0x5d, 0xaa, 0xd6, 0xea, 0x9c, 0x1b, 0xf4, 0xfe, 0xf6, 0x1c, 0x8a, 0x12,
+	0xe8, 0x31, 0xd8, 0x68, 0xe9, 0xd2, 0x2d, 0xf1, 0x85, 0xb9, 0xf7, 0x38, 0x45, 0xcf, 0x99, 0x73,
+	0x4f, 0xe4, 0x9c, 0xc1, 0x57, 0x76, 0xfb, 0x34, 0xc9, 0xdf, 0x47, 0x69, 0xb1, 0xe6, 0xdc, 0x27,
+	0xf6, 0xb7, 0xe7, 0x50, 0xf2, 0x62, 0x95, 0x2e, 0x38, 0xb5, 0x58, 0xf3, 0xef, 0x88, 0xfb, 0xe7,
+	0xe7, 0x13, 0xd5, 0x78, 0xb7, 0xa1, 0xbe, 0x4f, 0x13, 0xd9, 0x02, 0xb6, 0xbb, 0xd9, 0xd5, 0x86,
+	0xf9, 0x77, 0x3d, 0x8f, 0x52, 0x7f, 0xec, 0xc2, 0x3a, 0xf6, 0x71, 0xb2, 0xd6, 0xb1, 0xb0, 0xb7,
+	0x0b, 0x8d, 0xde, 0xec, 0xef, 0xad, 0x19, 0xbc, 0xd1, 0x45, 0xbb, 0xd0, 0x0a, 0xb2, 0x95, 0x88,
+	0xf3, 0x5a, 0xc1, 0xfd, 0x73, 0xf3, 0x48, 0x6a, 0x98, 0xfb, 0xd0, 0x41, 0x3c, 0x76, 0xe3, 0x0e,
+	0x02, 0x82, 0xe9, 0x56, 0x5b, 0x60, 0x4e, 0x7b, 0x6e, 0xd9, 0x38, 0x1f, 0x48, 0x1f, 0xcf, 0x4e,
+	0x84, 0x85, 0xce, 0x84, 0x7d, 0xb1, 0xd8, 0x5a, 0x28, 0x36, 0x80, 0xfa, 0xfd, 0x05, 0x54, 0x13,
+	0x96, 0xe7, 0xd3, 0x74, 0xf1, 0x42, 0x07, 0xde, 0x83, 0x4e, 0x41, 0x64, 0x66, 0x54, 0x38, 0xa7,
+	0xcd, 0xd1, 0x3f, 0x3b, 0x73, 0xe2, 0x2d, 0xf8, 0x64, 0x7e, 0x57, 0x5e, 0x92, 0x80, 0xb6, 0xf3,
+	0x94, 0x6c, 0x0b, 0x77, 0xce, 0xd8, 0x6f, 0x03, 0xec, 0xd3, 0x44, 0xa7, 0x62, 0x7b, 0x33, 0x9f,
+	0x98, 0xcd, 0xcf, 0xdd, 0x22, 0x32, 0xf5, 0xa4, 0xec, 0x3f, 0xb9, 0xcd, 0xe8, 0xe9, 0xe7, 0xec,
+	0x3c, 0x7d, 0xbb, 0x40, 0xc9, 0xad, 0x40, 0x9f, 0xbd, 0x0f, 0xa9, 0x3a, 0x8a, 0xeb, 0x8c, 0xb7,
+	0xf0, 0x5c, 0xde, 0xef, 0x28, 0xba, 0xa9, 0x72, 0x9d, 0x33, 0xb7, 0xad, 0xc3, 0x9a, 0x4c, 0x30,
+	0x77, 0xfe, 0x31, 0x00, 0xf7, 0x57, 0x3a, 0xc1, 0x30, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EDInfoCenterClient is the client API for EDInfoCenter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EDInfoCenterClient interface {
+	GetDistance(ctx context.Context, in *SystemsDistanceRequest, opts ...grpc.CallOption) (*SystemsDistanceReply, error)
+	GetSystemSummary(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*SystemSummaryReply, error)
+	GetDockableStations(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*DockableStationsReply, error)
+	GetMostVisitedSystems(ctx context.Context, in *MostVisitedSystemsRequest, opts ...grpc.CallOption) (*MostVisitedSystemsReply, error)
+	GetInterestingSystem4State(ctx context.Context, in *InterestingSystem4StateRequest, opts ...grpc.CallOption) (*InterestingSystem4StateReply, error)
+	GetHumanWorldStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HumanWorldStat, error)
+	GetGalaxyActivityStat(ctx context.Context, in *ActivityStatRequest, opts ...grpc.CallOption) (*ActivityStatReply, error)
+	GetStationActivity(ctx context.Context, in *StationActivityRequest, opts ...grpc.CallOption) (*StationActivityReply, error)
+	GetUploaderStat(ctx context.Context, in *UploaderStatRequest, opts ...grpc.CallOption) (*UploaderStatReply, error)
+	GetTrendingSystems(ctx context.Context, in *TrendingSystemsRequest, opts ...grpc.CallOption) (*TrendingSystemsReply, error)
+	GetRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*RouteReply, error)
+	FindTradeRoutes(ctx context.Context, in *TradeRouteRequest, opts ...grpc.CallOption) (*TradeRouteReply, error)
+	FindCommodity(ctx context.Context, in *FindCommodityRequest, opts ...grpc.CallOption) (*FindCommodityReply, error)
+	FindSellPlaces(ctx context.Context, in *SellCommodityRequest, opts ...grpc.CallOption) (*FindCommodityReply, error)
+	GetFactionInfluenceHistory(ctx context.Context, in *InfluenceHistoryRequest, opts ...grpc.CallOption) (*InfluenceHistoryReply, error)
+	GetSystemInfluenceHistory(ctx context.Context, in *InfluenceHistoryRequest, opts ...grpc.CallOption) (*InfluenceHistoryReply, error)
+	GetFactionInfo(ctx context.Context, in *FactionByNameRequest, opts ...grpc.CallOption) (*FactionInfoReply, error)
+	GetSystemBodies(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*SystemBodiesReply, error)
+	GetCarrier(ctx context.Context, in *CarrierRequest, opts ...grpc.CallOption) (*CarrierReply, error)
+	GetCarriersNear(ctx context.Context, in *CarriersNearRequest, opts ...grpc.CallOption) (*CarriersReply, error)
+	SubscribeEvents(ctx context.Context, in *EventsSubscriptionRequest, opts ...grpc.CallOption) (EDInfoCenter_SubscribeEventsClient, error)
+}
+
+type eDInfoCenterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEDInfoCenterClient(cc *grpc.ClientConn) EDInfoCenterClient {
+	return &eDInfoCenterClient{cc}
+}
+
+func (c *eDInfoCenterClient) GetDistance(ctx context.Context, in *SystemsDistanceRequest, opts ...grpc.CallOption) (*SystemsDistanceReply, error) {
+	out := new(SystemsDistanceReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetDistance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetSystemSummary(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*SystemSummaryReply, error) {
+	out := new(SystemSummaryReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetSystemSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetDockableStations(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*DockableStationsReply, error) {
+	out := new(DockableStationsReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetDockableStations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetMostVisitedSystems(ctx context.Context, in *MostVisitedSystemsRequest, opts ...grpc.CallOption) (*MostVisitedSystemsReply, error) {
+	out := new(MostVisitedSystemsReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetMostVisitedSystems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetInterestingSystem4State(ctx context.Context, in *InterestingSystem4StateRequest, opts ...grpc.CallOption) (*InterestingSystem4StateReply, error) {
+	out := new(InterestingSystem4StateReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetInterestingSystem4State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetHumanWorldStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HumanWorldStat, error) {
+	out := new(HumanWorldStat)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetHumanWorldStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetGalaxyActivityStat(ctx context.Context, in *ActivityStatRequest, opts ...grpc.CallOption) (*ActivityStatReply, error) {
+	out := new(ActivityStatReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetGalaxyActivityStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetStationActivity(ctx context.Context, in *StationActivityRequest, opts ...grpc.CallOption) (*StationActivityReply, error) {
+	out := new(StationActivityReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetStationActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetUploaderStat(ctx context.Context, in *UploaderStatRequest, opts ...grpc.CallOption) (*UploaderStatReply, error) {
+	out := new(UploaderStatReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetUploaderStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetTrendingSystems(ctx context.Context, in *TrendingSystemsRequest, opts ...grpc.CallOption) (*TrendingSystemsReply, error) {
+	out := new(TrendingSystemsReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetTrendingSystems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetRoute(ctx context.Context, in *RouteRequest, opts ...grpc.CallOption) (*RouteReply, error) {
+	out := new(RouteReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) FindTradeRoutes(ctx context.Context, in *TradeRouteRequest, opts ...grpc.CallOption) (*TradeRouteReply, error) {
+	out := new(TradeRouteReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/FindTradeRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) FindCommodity(ctx context.Context, in *FindCommodityRequest, opts ...grpc.CallOption) (*FindCommodityReply, error) {
+	out := new(FindCommodityReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/FindCommodity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) FindSellPlaces(ctx context.Context, in *SellCommodityRequest, opts ...grpc.CallOption) (*FindCommodityReply, error) {
+	out := new(FindCommodityReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/FindSellPlaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetFactionInfluenceHistory(ctx context.Context, in *InfluenceHistoryRequest, opts ...grpc.CallOption) (*InfluenceHistoryReply, error) {
+	out := new(InfluenceHistoryReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetFactionInfluenceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetSystemInfluenceHistory(ctx context.Context, in *InfluenceHistoryRequest, opts ...grpc.CallOption) (*InfluenceHistoryReply, error) {
+	out := new(InfluenceHistoryReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetSystemInfluenceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetFactionInfo(ctx context.Context, in *FactionByNameRequest, opts ...grpc.CallOption) (*FactionInfoReply, error) {
+	out := new(FactionInfoReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetFactionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetSystemBodies(ctx context.Context, in *SystemByNameRequest, opts ...grpc.CallOption) (*SystemBodiesReply, error) {
+	out := new(SystemBodiesReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetSystemBodies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetCarrier(ctx context.Context, in *CarrierRequest, opts ...grpc.CallOption) (*CarrierReply, error) {
+	out := new(CarrierReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetCarrier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) GetCarriersNear(ctx context.Context, in *CarriersNearRequest, opts ...grpc.CallOption) (*CarriersReply, error) {
+	out := new(CarriersReply)
+	err := c.cc.Invoke(ctx, "/api.EDInfoCenter/GetCarriersNear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eDInfoCenterClient) SubscribeEvents(ctx context.Context, in *EventsSubscriptionRequest, opts ...grpc.CallOption) (EDInfoCenter_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EDInfoCenter_serviceDesc.Streams[0], "/api.EDInfoCenter/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eDInfoCenterSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EDInfoCenter_SubscribeEventsClient interface {
+	Recv() (*EDDNEvent, error)
+	grpc.ClientStream
+}
+
+type eDInfoCenterSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eDInfoCenterSubscribeEventsClient) Recv() (*EDDNEvent, error) {
+	m := new(EDDNEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EDInfoCenterServer is the server API for EDInfoCenter service.
+type EDInfoCenterServer interface {
+	GetDistance(context.Context, *SystemsDistanceRequest) (*SystemsDistanceReply, error)
+	GetSystemSummary(context.Context, *SystemByNameRequest) (*SystemSummaryReply, error)
+	GetDockableStations(context.Context, *SystemByNameRequest) (*DockableStationsReply, error)
+	GetMostVisitedSystems(context.Context, *MostVisitedSystemsRequest) (*MostVisitedSystemsReply, error)
+	GetInterestingSystem4State(context.Context, *InterestingSystem4StateRequest) (*InterestingSystem4StateReply, error)
+	GetHumanWorldStat(context.Context, *empty.Empty) (*HumanWorldStat, error)
+	GetGalaxyActivityStat(context.Context, *ActivityStatRequest) (*ActivityStatReply, error)
+	GetStationActivity(context.Context, *StationActivityRequest) (*StationActivityReply, error)
+	GetUploaderStat(context.Context, *UploaderStatRequest) (*UploaderStatReply, error)
+	GetTrendingSystems(context.Context, *TrendingSystemsRequest) (*TrendingSystemsReply, error)
+	GetRoute(context.Context, *RouteRequest) (*RouteReply, error)
+	FindTradeRoutes(context.Context, *TradeRouteRequest) (*TradeRouteReply, error)
+	FindCommodity(context.Context, *FindCommodityRequest) (*FindCommodityReply, error)
+	FindSellPlaces(context.Context, *SellCommodityRequest) (*FindCommodityReply, error)
+	GetFactionInfluenceHistory(context.Context, *InfluenceHistoryRequest) (*InfluenceHistoryReply, error)
+	GetSystemInfluenceHistory(context.Context, *InfluenceHistoryRequest) (*InfluenceHistoryReply, error)
+	GetFactionInfo(context.Context, *FactionByNameRequest) (*FactionInfoReply, error)
+	GetSystemBodies(context.Context, *SystemByNameRequest) (*SystemBodiesReply, error)
+	GetCarrier(context.Context, *CarrierRequest) (*CarrierReply, error)
+	GetCarriersNear(context.Context, *CarriersNearRequest) (*CarriersReply, error)
+	SubscribeEvents(*EventsSubscriptionRequest, EDInfoCenter_SubscribeEventsServer) error
+}
+
+func RegisterEDInfoCenterServer(s *grpc.Server, srv EDInfoCenterServer) {
+	s.RegisterService(&_EDInfoCenter_serviceDesc, srv)
+}
+
+func _EDInfoCenter_GetDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemsDistanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
 		return srv.(EDInfoCenterServer).GetDistance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
@@ -1480,6 +4827,261 @@ func _EDInfoCenter_GetGalaxyActivityStat_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EDInfoCenter_GetStationActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StationActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetStationActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetStationActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetStationActivity(ctx, req.(*StationActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetUploaderStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploaderStatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetUploaderStat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetUploaderStat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetUploaderStat(ctx, req.(*UploaderStatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetTrendingSystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendingSystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetTrendingSystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetTrendingSystems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetTrendingSystems(ctx, req.(*TrendingSystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetRoute(ctx, req.(*RouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_FindTradeRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).FindTradeRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/FindTradeRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).FindTradeRoutes(ctx, req.(*TradeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_FindCommodity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCommodityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).FindCommodity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/FindCommodity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).FindCommodity(ctx, req.(*FindCommodityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_FindSellPlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellCommodityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).FindSellPlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/FindSellPlaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).FindSellPlaces(ctx, req.(*SellCommodityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetFactionInfluenceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InfluenceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetFactionInfluenceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetFactionInfluenceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetFactionInfluenceHistory(ctx, req.(*InfluenceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetSystemInfluenceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InfluenceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetSystemInfluenceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetSystemInfluenceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetSystemInfluenceHistory(ctx, req.(*InfluenceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetFactionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FactionByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetFactionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetFactionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetFactionInfo(ctx, req.(*FactionByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetSystemBodies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetSystemBodies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetSystemBodies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetSystemBodies(ctx, req.(*SystemByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetCarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarrierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetCarrier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetCarrier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetCarrier(ctx, req.(*CarrierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_GetCarriersNear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarriersNearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EDInfoCenterServer).GetCarriersNear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EDInfoCenter/GetCarriersNear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EDInfoCenterServer).GetCarriersNear(ctx, req.(*CarriersNearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EDInfoCenter_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsSubscriptionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EDInfoCenterServer).SubscribeEvents(m, &eDInfoCenterSubscribeEventsServer{stream})
+}
+
+type EDInfoCenter_SubscribeEventsServer interface {
+	Send(*EDDNEvent) error
+	grpc.ServerStream
+}
+
+type eDInfoCenterSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eDInfoCenterSubscribeEventsServer) Send(m *EDDNEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _EDInfoCenter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.EDInfoCenter",
 	HandlerType: (*EDInfoCenterServer)(nil),
@@ -1512,7 +5114,65 @@ var _EDInfoCenter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetGalaxyActivityStat",
 			Handler:    _EDInfoCenter_GetGalaxyActivityStat_Handler,
 		},
+		{
+			MethodName: "GetStationActivity",
+			Handler:    _EDInfoCenter_GetStationActivity_Handler,
+		},
+		{
+			MethodName: "GetUploaderStat",
+			Handler:    _EDInfoCenter_GetUploaderStat_Handler,
+		},
+		{
+			MethodName: "GetTrendingSystems",
+			Handler:    _EDInfoCenter_GetTrendingSystems_Handler,
+		},
+		{
+			MethodName: "GetRoute",
+			Handler:    _EDInfoCenter_GetRoute_Handler,
+		},
+		{
+			MethodName: "FindTradeRoutes",
+			Handler:    _EDInfoCenter_FindTradeRoutes_Handler,
+		},
+		{
+			MethodName: "FindCommodity",
+			Handler:    _EDInfoCenter_FindCommodity_Handler,
+		},
+		{
+			MethodName: "FindSellPlaces",
+			Handler:    _EDInfoCenter_FindSellPlaces_Handler,
+		},
+		{
+			MethodName: "GetFactionInfluenceHistory",
+			Handler:    _EDInfoCenter_GetFactionInfluenceHistory_Handler,
+		},
+		{
+			MethodName: "GetSystemInfluenceHistory",
+			Handler:    _EDInfoCenter_GetSystemInfluenceHistory_Handler,
+		},
+		{
+			MethodName: "GetFactionInfo",
+			Handler:    _EDInfoCenter_GetFactionInfo_Handler,
+		},
+		{
+			MethodName: "GetSystemBodies",
+			Handler:    _EDInfoCenter_GetSystemBodies_Handler,
+		},
+		{
+			MethodName: "GetCarrier",
+			Handler:    _EDInfoCenter_GetCarrier_Handler,
+		},
+		{
+			MethodName: "GetCarriersNear",
+			Handler:    _EDInfoCenter_GetCarriersNear_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _EDInfoCenter_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobuf-spec/edicenter.proto",
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/bwmarrin/discordgo"
 	"github.com/spf13/viper"
 	"goed/cyborg"
+	"goed/metrics"
 	"io"
 	"io/ioutil"
 	"log"
@@ -58,6 +59,7 @@ func main() {
 	debug := flag.Bool("debug", false, "switch on debuging mode")
 	silent := flag.Bool("silent", false, "be silent")
 	pprofAddr := flag.String("pprof", "", "host:port for pprof")
+	metricsAddr := flag.String("metrics", "", "host:port for the Prometheus metrics at /metrics, may be the pprof one")
 
 	flag.Parse()
 	loglevel := discordgo.LogInformational
@@ -82,6 +84,19 @@ func main() {
 		return
 	}
 
+	if len(*metricsAddr) > 4 {
+		if *metricsAddr == *pprofAddr {
+			http.Handle("/metrics", metrics.Handler())
+		} else {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metrics.Handler())
+			go func() {
+				log.Printf("Starting metrics at %s\n", *metricsAddr)
+				log.Println(http.ListenAndServe(*metricsAddr, mux))
+			}()
+		}
+	}
+
 	if len(*pprofAddr) > 4 {
 		go func() {
 			log.Println(http.ListenAndServe(*pprofAddr, nil))
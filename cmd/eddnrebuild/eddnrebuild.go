@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"goed/eddb"
+	"log"
+	"os"
+	"time"
+)
+
+/*
+	Rebuilds the ship visit statistics from the EDDN archive,
+	the result is the backup edicenter restores on start (StarStat.BackupFile)
+	or migrates to the empty stat store (StarStat.StoreFile)
+*/
+func main() {
+	archiveDir := flag.String("archive", "", "The EDDN archive directory")
+	from := flag.String("from", "", "The first day to replay, YYYY-MM-DD. Every archived day if empty")
+	out := flag.String("out", "", "The visit statistics backup file to write")
+	flag.Parse()
+
+	if len(*archiveDir) == 0 || len(*out) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	files, err := eddb.ArchiveFiles(*archiveDir)
+	if err != nil {
+		log.Fatalf("Failed to list the archive %s: %v\n", *archiveDir, err)
+	}
+	first := ""
+	if len(*from) > 0 {
+		day, err := time.Parse("2006-01-02", *from)
+		if err != nil {
+			log.Fatalf("Bad day %s: %v\n", *from, err)
+		}
+		first = eddb.ArchiveFileName(*archiveDir, day)
+	}
+
+	collector := eddb.NewShipStatCollector()
+	total := 0
+	for _, fn := range files {
+		if fn < first {
+			continue
+		}
+		src, err := eddb.NewReplaySource(fn, 0)
+		if err != nil {
+			log.Printf("Skipping %s: %v\n", fn, err)
+			continue
+		}
+		n, err := collector.Replay(src)
+		src.Close()
+		if err != nil {
+			log.Printf("Replay of %s stopped after %d messages: %v\n", fn, n, err)
+		} else {
+			log.Printf("Replayed %d messages from %s\n", n, fn)
+		}
+		total += n
+	}
+	log.Printf("Replayed %d messages\n", total)
+
+	ok := collector.Backup(*out)
+	collector.Shutdown()
+	if !ok {
+		os.Exit(1)
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/jasonlvhit/gocron"
 	"github.com/spf13/viper"
+	"goed/edGalaxy"
 	"goed/eddb"
 	"goed/edgic"
+	"goed/metrics"
 	"io"
 	"io/ioutil"
 	"log"
@@ -17,17 +19,79 @@ import (
 	"os/signal"
 	"runtime"
 	"syscall"
+	"time"
 )
 
+/*
+	With StoreFile the stat is kept in the embedded database, the changes
+	are written every BackupPeriod and BackupFile is only migrated
+	to the empty store. Without it the whole stat is dumped to BackupFile.
+*/
 type StarStatCfg struct {
+	StoreFile    string
 	BackupFile   string
 	BackupPeriod uint64
+	// the visit stat resolutions, the finest first, edGalaxy.DefaultVisitStatTiers if empty
+	Tiers []edGalaxy.VisitStatTier
+}
+/*
+	The system states not reported for MaxAgeHours are dropped, a week if 0
+*/
+type LiveStatesCfg struct {
+	MaxAgeHours int
+}
+/*
+	The markets not updated for MaxAgeHours are dropped, 0 - never
+*/
+type LiveMarketsCfg struct {
+	BackupFile   string
+	BackupPeriod uint64
+	MaxAgeHours  int
+}
+/*
+	The carriers not seen for MaxAgeDays are dropped, 0 - never
+*/
+type CarriersCfg struct {
+	BackupFile   string
+	BackupPeriod uint64
+	MaxAgeDays   int
+}
+/*
+	The uploader hours older than MaxAgeHours are dropped, a week if 0
+*/
+type UploadersCfg struct {
+	BackupFile   string
+	BackupPeriod uint64
+	MaxAgeHours  int
+}
+type InfluenceHistoryCfg struct {
+	BackupFile   string
+	BackupPeriod uint64
+	Days         int // the points and the series not reported for longer are dropped
+}
+/*
+	The relay is dialed unless the replay file is set,
+	ReplaySpeed 1 keeps the recorded pace, 0 - as fast as possible
+*/
+type EDDNCfg struct {
+	Relay       string
+	ReplayFile  string
+	ReplaySpeed float64
+	Archive     eddb.EDDNArchiveConfig // no archive if Dir is empty
+	// the most recently scanned systems to keep the bodies for, 10000 if 0 or less
+	MaxScannedSystems int
 }
 type EDInfoCenterConf struct {
-	EDDBCache   eddb.DataCacheConfig
-	CheckPeriod uint64
-	GrpcSrv     edgic.GrpcServerConf
-	StarStat    StarStatCfg
+	EDDBCache        eddb.DataCacheConfig
+	EDDN             EDDNCfg
+	CheckPeriod      uint64
+	GrpcSrv          edgic.GrpcServerConf
+	StarStat         StarStatCfg
+	InfluenceHistory InfluenceHistoryCfg
+	LiveStates       LiveStatesCfg
+	LiveMarkets      LiveMarketsCfg
+	Carriers         CarriersCfg
+	Uploaders        UploadersCfg
 }
 
 func loadConfig(path string) (*EDInfoCenterConf, error) {
@@ -87,6 +151,7 @@ func printMemUsage() {
 
 func main() {
 	pprofAddr := flag.String("pprof", "", "host:port for pprof")
+	metricsAddr := flag.String("metrics", "", "host:port for the Prometheus metrics at /metrics, may be the pprof one")
 	silent := flag.Bool("noout", false, "Exclude stdout from logging")
 	logFileName := flag.String("logfile", "edicenter.log", "Log file name. Logging to file will be disabled if empty")
 
@@ -102,6 +167,19 @@ func main() {
 		return
 	}
 
+	if len(*metricsAddr) > 4 {
+		if *metricsAddr == *pprofAddr {
+			http.Handle("/metrics", metrics.Handler())
+		} else {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metrics.Handler())
+			go func() {
+				log.Printf("Starting metrics at %s\n", *metricsAddr)
+				log.Println(http.ListenAndServe(*metricsAddr, mux))
+			}()
+		}
+	}
+
 	if len(*pprofAddr) > 4 {
 		go func() {
 			log.Printf("Starting pprof at %s\n", *pprofAddr)
@@ -113,8 +191,15 @@ func main() {
 	dc.CheckForUpdates()
 
 	ediSrv := edgic.NewGIServer(cfg.GrpcSrv)
+	liveStates := eddb.NewLiveSystemStates()
+	liveMarkets := eddb.NewLiveMarkets()
+	if len(cfg.LiveMarkets.BackupFile) > 0 {
+		liveMarkets.Restore(cfg.LiveMarkets.BackupFile)
+	}
 	eddbInfo, err := eddb.BuildEDDBInfo(&cfg.EDDBCache)
 	if err == nil {
+		eddbInfo.SetLiveStates(liveStates)
+		eddbInfo.SetLiveMarkets(liveMarkets)
 		ediSrv.SetEDDBData(eddbInfo)
 	} else {
 		log.Print("Failed to load initial galaxy info\n")
@@ -127,13 +212,22 @@ func main() {
 			return
 		}
 		if updates != nil && len(updates) > 0 {
-			eddbInfo, err := eddb.BuildEDDBInfo(&cfg.EDDBCache)
+			// only the updated files are re-read, the current info is kept on failure
+			var fresh *eddb.EDDBInfo
+			if eddbInfo != nil {
+				fresh, err = eddbInfo.Refresh(&cfg.EDDBCache, updates)
+			} else {
+				fresh, err = eddb.BuildEDDBInfo(&cfg.EDDBCache)
+			}
 			if err == nil {
+				fresh.SetLiveStates(liveStates)
+				fresh.SetLiveMarkets(liveMarkets)
+				eddbInfo = fresh
 				ediSrv.SetEDDBData(eddbInfo)
 				log.Println("New galaxy info is set")
 				printMemUsage()
 			} else {
-				log.Print("Failed to load initial galaxy info\n")
+				log.Print("Failed to update galaxy info\n")
 			}
 		}
 	}
@@ -142,11 +236,103 @@ func main() {
 
 
 	eddnListener := eddb.NewShipStatCollector()
-	if len(cfg.StarStat.BackupFile) > 0 {
+	if len(cfg.EDDN.ReplayFile) > 0 {
+		replay, err := eddb.NewReplaySource(cfg.EDDN.ReplayFile, cfg.EDDN.ReplaySpeed)
+		if err != nil {
+			log.Fatalf("Failed to open EDDN replay %s: %v\n", cfg.EDDN.ReplayFile, err)
+			return
+		}
+		log.Printf("Replaying EDDN from %s\n", cfg.EDDN.ReplayFile)
+		eddnListener.SetSource(replay)
+	} else {
+		eddnListener.SetSource(eddb.NewRelaySource(cfg.EDDN.Relay))
+	}
+	liveStatesMaxAge := cfg.LiveStates.MaxAgeHours
+	if liveStatesMaxAge <= 0 {
+		liveStatesMaxAge = 7 * 24
+	}
+	gocron.Every(1).Hour().Do(liveStates.Expire, time.Duration(liveStatesMaxAge)*time.Hour)
+	eddnListener.AddFSDJumpListener(liveStates)
+	eddnListener.AddMarketListener(liveMarkets)
+	if len(cfg.LiveMarkets.BackupFile) > 0 {
+		gocron.Every(cfg.LiveMarkets.BackupPeriod).Seconds().Do(liveMarkets.Backup, cfg.LiveMarkets.BackupFile)
+	}
+	if cfg.LiveMarkets.MaxAgeHours > 0 {
+		gocron.Every(1).Hour().Do(liveMarkets.Expire, time.Duration(cfg.LiveMarkets.MaxAgeHours)*time.Hour)
+	}
+	var archiver *eddb.EDDNArchiver
+	if len(cfg.EDDN.Archive.Dir) > 0 {
+		archiver, err = eddb.NewEDDNArchiver(cfg.EDDN.Archive)
+		if err != nil {
+			log.Fatalf("Failed to start EDDN archive at %s: %v\n", cfg.EDDN.Archive.Dir, err)
+			return
+		}
+		eddnListener.AddMessageListener(archiver)
+	}
+	eddnListener.SetVisitStatTiers(cfg.StarStat.Tiers)
+	if len(cfg.StarStat.StoreFile) > 0 {
+		if !eddnListener.OpenStore(cfg.StarStat.StoreFile, cfg.StarStat.BackupFile) {
+			log.Fatalf("Failed to open the stat store %s\n", cfg.StarStat.StoreFile)
+			return
+		}
+		gocron.Every(cfg.StarStat.BackupPeriod).Seconds().Do(eddnListener.Flush)
+	} else if len(cfg.StarStat.BackupFile) > 0 {
 		eddnListener.Restore(cfg.StarStat.BackupFile)
 		gocron.Every(cfg.StarStat.BackupPeriod).Seconds().Do(eddnListener.Backup, cfg.StarStat.BackupFile)
 	}
 	ediSrv.SetVisitsStatProvider(eddnListener)
+
+	influenceDays := cfg.InfluenceHistory.Days
+	if influenceDays <= 0 {
+		influenceDays = 60
+	}
+	influenceHistory := eddb.NewInfluenceHistory(influenceDays)
+	if len(cfg.InfluenceHistory.BackupFile) > 0 {
+		influenceHistory.Restore(cfg.InfluenceHistory.BackupFile)
+		gocron.Every(cfg.InfluenceHistory.BackupPeriod).Seconds().Do(influenceHistory.Backup, cfg.InfluenceHistory.BackupFile)
+	}
+	gocron.Every(1).Hour().Do(influenceHistory.Expire, time.Duration(influenceDays)*24*time.Hour)
+	eddnListener.AddFSDJumpListener(influenceHistory)
+	ediSrv.SetInfluenceHistoryProvider(influenceHistory)
+
+	bodies := eddb.NewBodyRegistry(cfg.EDDN.MaxScannedSystems)
+	eddnListener.AddScanListener(bodies)
+	ediSrv.SetBodiesProvider(bodies)
+
+	carriers := eddb.NewCarrierRegistry()
+	if len(cfg.Carriers.BackupFile) > 0 {
+		carriers.Restore(cfg.Carriers.BackupFile)
+		gocron.Every(cfg.Carriers.BackupPeriod).Seconds().Do(carriers.Backup, cfg.Carriers.BackupFile)
+	}
+	if cfg.Carriers.MaxAgeDays > 0 {
+		gocron.Every(1).Hour().Do(carriers.Expire, time.Duration(cfg.Carriers.MaxAgeDays)*24*time.Hour)
+	}
+	eddnListener.AddCarrierJumpListener(carriers)
+	eddnListener.AddDockedListener(carriers)
+	ediSrv.SetCarriersProvider(carriers)
+
+	uploaders := eddb.NewUploaderRegistry()
+	if len(cfg.Uploaders.BackupFile) > 0 {
+		uploaders.Restore(cfg.Uploaders.BackupFile)
+		gocron.Every(cfg.Uploaders.BackupPeriod).Seconds().Do(uploaders.Backup, cfg.Uploaders.BackupFile)
+	}
+	uploadersMaxAge := cfg.Uploaders.MaxAgeHours
+	if uploadersMaxAge <= 0 {
+		uploadersMaxAge = 7 * 24
+	}
+	gocron.Every(1).Hour().Do(uploaders.Expire, time.Duration(uploadersMaxAge)*time.Hour)
+	metrics.NewGaugeFunc("eddn_uploaders_tracked", "The EDDN uploaders seen within the max age", func() float64 {
+		return float64(uploaders.Len())
+	})
+	eddnListener.AddFSDJumpListener(uploaders)
+	eddnListener.AddDockedListener(uploaders)
+	eddnListener.AddScanListener(uploaders)
+	ediSrv.SetUploaderStatProvider(uploaders)
+
+	eventFeed := edgic.NewEventFeed()
+	eddnListener.AddFSDJumpListener(eventFeed)
+	eddnListener.AddDockedListener(eventFeed)
+	ediSrv.SetEventFeed(eventFeed)
 	go ediSrv.Serve()
 
 	eddnListener.StartListen()
@@ -171,9 +357,24 @@ func main() {
 	signal.Notify(sc, syscall.SIGINT, syscall.SIGTERM, os.Interrupt, os.Kill)
 	<-sc
 
-	if len(cfg.StarStat.BackupFile) > 0 {
+	if len(cfg.StarStat.StoreFile) == 0 && len(cfg.StarStat.BackupFile) > 0 {
 		eddnListener.Backup(cfg.StarStat.BackupFile)
 	}
+	if len(cfg.InfluenceHistory.BackupFile) > 0 {
+		influenceHistory.Backup(cfg.InfluenceHistory.BackupFile)
+	}
+	if len(cfg.LiveMarkets.BackupFile) > 0 {
+		liveMarkets.Backup(cfg.LiveMarkets.BackupFile)
+	}
+	if len(cfg.Carriers.BackupFile) > 0 {
+		carriers.Backup(cfg.Carriers.BackupFile)
+	}
+	if len(cfg.Uploaders.BackupFile) > 0 {
+		uploaders.Backup(cfg.Uploaders.BackupFile)
+	}
+	if archiver != nil {
+		archiver.Close()
+	}
 
 	eddnListener.Shutdown()
 }
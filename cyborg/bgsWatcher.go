@@ -0,0 +1,346 @@
+package cyborg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/bwmarrin/discordgo"
+	"goed/edGalaxy"
+	"goed/edgic"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBGSPollPeriod = 600
+	bgsPollDays          = 2
+)
+
+var defaultBGSStates = []string{"War", "Civil War", "Election", "Expansion", "Retreat"}
+
+/*
+	A faction watched for one channel. The influence thresholds are in percents,
+	0 switches the threshold off.
+*/
+type BGSWatch struct {
+	Faction        string
+	ChannelID      string
+	States         []string // defaults to War, Civil War, Election, Expansion and Retreat
+	InfluenceAbove float64
+	InfluenceBelow float64
+}
+
+type BGSAlertsConfig struct {
+	PollPeriod uint64     // seconds
+	StateFile  string     // the watches added by the operators and the conditions already reported
+	Watches    []BGSWatch // the initial watches, taken only if there is no state yet
+}
+
+type bgsWatcherState struct {
+	Watches  []*BGSWatch         `json:"watches"`
+	Reported map[string][]string `json:"reported"` // watch key -> conditions
+}
+
+/*
+	Polls the faction influence history and posts the changes of the watched
+	factions. A condition is reported once, until it is gone.
+*/
+type bgsWatcher struct {
+	giClient   *edgic.EDInfoCenterClient
+	pollPeriod time.Duration
+	stateFile  string
+
+	mtx      sync.Mutex
+	watches  map[string]*BGSWatch
+	reported map[string]map[string]bool // watch key -> conditions reported
+
+	backupMtx sync.Mutex // the snapshots are written in the order they are taken
+
+	ds        *discordgo.Session
+	done      chan bool
+	closeOnce sync.Once
+}
+
+func bgsWatchKey(faction, channelID string) string {
+	return strings.ToUpper(faction) + "|" + channelID
+}
+
+func normalizeBGSState(state string) string {
+	return strings.ToUpper(strings.Replace(state, " ", "", -1))
+}
+
+func newBGSWatcher(cfg *BGSAlertsConfig, giClient *edgic.EDInfoCenterClient) *bgsWatcher {
+	period := cfg.PollPeriod
+	if period == 0 {
+		period = defaultBGSPollPeriod
+	}
+	w := &bgsWatcher{
+		giClient:   giClient,
+		pollPeriod: time.Duration(period) * time.Second,
+		stateFile:  cfg.StateFile,
+		watches:    make(map[string]*BGSWatch),
+		reported:   make(map[string]map[string]bool),
+		done:       make(chan bool)}
+	// the state keeps the watches removed and edited by the operators
+	if !w.restore() {
+		for i := range cfg.Watches {
+			cw := cfg.Watches[i]
+			w.watches[bgsWatchKey(cw.Faction, cw.ChannelID)] = &cw
+		}
+	}
+	return w
+}
+
+func (w *bgsWatcher) start(ds *discordgo.Session) {
+	w.ds = ds
+	go w.watch()
+}
+
+func (w *bgsWatcher) close() {
+	w.closeOnce.Do(func() { close(w.done) })
+}
+
+func (w *bgsWatcher) watch() {
+	log.Printf("BGS watcher started, polling every %v\n", w.pollPeriod)
+	ticker := time.NewTicker(w.pollPeriod)
+	defer ticker.Stop()
+	w.poll()
+	for {
+		select {
+		case <-ticker.C:
+			w.poll()
+		case <-w.done:
+			log.Println("BGS watcher finished")
+			return
+		}
+	}
+}
+
+func (w *bgsWatcher) addWatch(watch *BGSWatch) {
+	w.mtx.Lock()
+	w.watches[bgsWatchKey(watch.Faction, watch.ChannelID)] = watch
+	w.mtx.Unlock()
+	w.backup()
+}
+
+func (w *bgsWatcher) removeWatch(faction, channelID string) bool {
+	key := bgsWatchKey(faction, channelID)
+	w.mtx.Lock()
+	_, exists := w.watches[key]
+	delete(w.watches, key)
+	delete(w.reported, key)
+	w.mtx.Unlock()
+	if exists {
+		w.backup()
+	}
+	return exists
+}
+
+func (w *bgsWatcher) listWatches() []*BGSWatch {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	watches := make([]*BGSWatch, 0, len(w.watches))
+	for _, watch := range w.watches {
+		watches = append(watches, watch)
+	}
+	sort.Slice(watches, func(i, j int) bool {
+		if watches[i].Faction != watches[j].Faction {
+			return watches[i].Faction < watches[j].Faction
+		}
+		return watches[i].ChannelID < watches[j].ChannelID
+	})
+	return watches
+}
+
+/*
+	The conditions holding in the latest report from every system,
+	by the condition key with the message to post
+*/
+func bgsConditions(watch *BGSWatch, h *edGalaxy.InfluenceHistory) map[string]string {
+	states := watch.States
+	if len(states) == 0 {
+		states = defaultBGSStates
+	}
+	wanted := make(map[string]bool)
+	for _, s := range states {
+		wanted[normalizeBGSState(s)] = true
+	}
+	conditions := make(map[string]string)
+	for _, s := range h.Series {
+		if len(s.Points) == 0 {
+			continue
+		}
+		p := s.Points[len(s.Points)-1]
+		if wanted[normalizeBGSState(p.State)] {
+			conditions[s.Name+"|state|"+normalizeBGSState(p.State)] =
+				fmt.Sprintf("**%s** is in %s in %s", h.Name, p.State, s.Name)
+		}
+		for _, ps := range p.PendingStates {
+			if wanted[normalizeBGSState(ps)] {
+				conditions[s.Name+"|pending|"+normalizeBGSState(ps)] =
+					fmt.Sprintf("**%s** has %s pending in %s", h.Name, ps, s.Name)
+			}
+		}
+		influence := p.Influence * 100
+		if watch.InfluenceAbove > 0 && influence >= watch.InfluenceAbove {
+			conditions[fmt.Sprintf("%s|above|%g", s.Name, watch.InfluenceAbove)] =
+				fmt.Sprintf("**%s** influence in %s is %.1f%%, above %g%%", h.Name, s.Name, influence, watch.InfluenceAbove)
+		}
+		if watch.InfluenceBelow > 0 && influence <= watch.InfluenceBelow {
+			conditions[fmt.Sprintf("%s|below|%g", s.Name, watch.InfluenceBelow)] =
+				fmt.Sprintf("**%s** influence in %s is %.1f%%, below %g%%", h.Name, s.Name, influence, watch.InfluenceBelow)
+		}
+	}
+	return conditions
+}
+
+/*
+	Keeps the conditions holding for the watch, returns the messages of the ones
+	not reported yet and if the reported ones changed. A condition gone and back is reported again.
+*/
+func (w *bgsWatcher) noteConditions(key string, conditions map[string]string) ([]string, bool) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	if _, stillWatched := w.watches[key]; !stillWatched {
+		return nil, false
+	}
+	reported := w.reported[key]
+	fresh := make(map[string]bool, len(conditions))
+	messages := make([]string, 0)
+	for cond, msg := range conditions {
+		fresh[cond] = true
+		if !reported[cond] {
+			messages = append(messages, msg)
+		}
+	}
+	sort.Strings(messages)
+	w.reported[key] = fresh
+	return messages, len(messages) > 0 || len(fresh) != len(reported)
+}
+
+func (w *bgsWatcher) poll() {
+	watches := w.listWatches()
+	histories := make(map[string]*edGalaxy.InfluenceHistory)
+	changed := false
+	for _, watch := range watches {
+		uf := strings.ToUpper(watch.Faction)
+		h, known := histories[uf]
+		if !known {
+			var err error
+			h, err = w.giClient.GetFactionInfluenceHistory(watch.Faction, bgsPollDays)
+			if err != nil {
+				log.Printf("BGS watcher: no influence for %s: %v\n", watch.Faction, err)
+			}
+			histories[uf] = h
+		}
+		if h == nil {
+			continue
+		}
+		messages, stateChanged := w.noteConditions(bgsWatchKey(watch.Faction, watch.ChannelID), bgsConditions(watch, h))
+		changed = changed || stateChanged
+
+		if len(messages) > 0 {
+			if _, err := SendMessage(w.ds, watch.ChannelID, strings.Join(messages, "\n")); err != nil {
+				log.Printf("BGS watcher: failed to post to %s: %v\n", watch.ChannelID, err)
+			}
+		}
+	}
+	if changed {
+		w.backup()
+	}
+}
+
+func (w *bgsWatcher) backup() bool {
+	if len(w.stateFile) == 0 {
+		return false
+	}
+	w.backupMtx.Lock()
+	defer w.backupMtx.Unlock()
+	w.mtx.Lock()
+	state := &bgsWatcherState{Watches: make([]*BGSWatch, 0, len(w.watches)), Reported: make(map[string][]string)}
+	for _, watch := range w.watches {
+		state.Watches = append(state.Watches, watch)
+	}
+	for key, conditions := range w.reported {
+		for cond := range conditions {
+			state.Reported[key] = append(state.Reported[key], cond)
+		}
+	}
+	data, err := json.MarshalIndent(state, "", " ")
+	w.mtx.Unlock()
+	tmpName := w.stateFile + ".tmp"
+	if err == nil {
+		err = ioutil.WriteFile(tmpName, data, 0644)
+	}
+	if err != nil {
+		log.Printf("BGS watcher backup to %s failed: %v\n", w.stateFile, err)
+		os.Remove(tmpName)
+		return false
+	}
+	if err = os.Rename(tmpName, w.stateFile); err != nil {
+		log.Printf("Rename %s -> %s failed: %v\n", tmpName, w.stateFile, err)
+		return false
+	}
+	return true
+}
+
+func (w *bgsWatcher) restore() bool {
+	if len(w.stateFile) == 0 {
+		return false
+	}
+	data, err := ioutil.ReadFile(w.stateFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("BGS watcher restore from %s failed: %v\n", w.stateFile, err)
+		}
+		return false
+	}
+	var state bgsWatcherState
+	if err = json.Unmarshal(data, &state); err != nil {
+		log.Printf("BGS watcher restore from %s failed: %v\n", w.stateFile, err)
+		return false
+	}
+	for _, watch := range state.Watches {
+		w.watches[bgsWatchKey(watch.Faction, watch.ChannelID)] = watch
+	}
+	for key, conditions := range state.Reported {
+		w.reported[key] = make(map[string]bool)
+		for _, cond := range conditions {
+			w.reported[key][cond] = true
+		}
+	}
+	log.Printf("BGS watcher restored %d watches from %s\n", len(state.Watches), w.stateFile)
+	return true
+}
+
+/*
+	watch add <channelId> <faction name> [above <N>%] [below <N>%] [states <state>,<state>...]
+*/
+func parseBGSWatch(channelID, rest string) (*BGSWatch, error) {
+	watch := &BGSWatch{ChannelID: channelID}
+	if pos := strings.Index(strings.ToLower(rest), " states "); pos >= 0 {
+		for _, s := range strings.Split(rest[pos+8:], ",") {
+			if s = strings.TrimSpace(s); len(s) > 0 {
+				watch.States = append(watch.States, s)
+			}
+		}
+		rest = rest[:pos]
+	}
+	mt := reBGSThresholds.FindStringSubmatch(rest)
+	if mt == nil {
+		return nil, errors.New("Expected: watch add <channelId> <faction name> [above <N>%] [below <N>%] [states <state>,...]")
+	}
+	watch.Faction = mt[1]
+	if len(mt[2]) > 0 {
+		fmt.Sscanf(mt[2], "%g", &watch.InfluenceAbove)
+	}
+	if len(mt[3]) > 0 {
+		fmt.Sscanf(mt[3], "%g", &watch.InfluenceBelow)
+	}
+	return watch, nil
+}
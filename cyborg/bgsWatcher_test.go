@@ -0,0 +1,140 @@
+package cyborg
+
+import (
+	"fmt"
+	"goed/edGalaxy"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func testInfluenceHistory(points map[string]*edGalaxy.InfluencePoint) *edGalaxy.InfluenceHistory {
+	h := &edGalaxy.InfluenceHistory{Name: "Pilots"}
+	for system, p := range points {
+		h.Series = append(h.Series, &edGalaxy.InfluenceSeries{
+			Name:   system,
+			Points: []*edGalaxy.InfluencePoint{&edGalaxy.InfluencePoint{Influence: 0.1}, p}})
+	}
+	return h
+}
+
+func conditionKeys(conditions map[string]string) []string {
+	keys := make([]string, 0, len(conditions))
+	for k := range conditions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestBGSConditions(t *testing.T) {
+	watch := &BGSWatch{Faction: "Pilots", InfluenceAbove: 50, InfluenceBelow: 10}
+	h := testInfluenceHistory(map[string]*edGalaxy.InfluencePoint{
+		"Sol":     &edGalaxy.InfluencePoint{Influence: 0.5, State: "CivilWar"},
+		"Lave":    &edGalaxy.InfluencePoint{Influence: 0.3, State: "Boom", PendingStates: []string{"Expansion"}},
+		"Achenar": &edGalaxy.InfluencePoint{Influence: 0.099},
+	})
+	expected := []string{"Achenar|below|10", "Lave|pending|EXPANSION", "Sol|above|50", "Sol|state|CIVILWAR"}
+	if got := conditionKeys(bgsConditions(watch, h)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	// only the latest point counts, the thresholds off at 0
+	watch = &BGSWatch{Faction: "Pilots", States: []string{"Boom"}}
+	expected = []string{"Lave|state|BOOM"}
+	if got := conditionKeys(bgsConditions(watch, h)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestBGSWatcherReportsOnce(t *testing.T) {
+	w := newBGSWatcher(&BGSAlertsConfig{Watches: []BGSWatch{{Faction: "Pilots", ChannelID: "1"}}}, nil)
+	key := bgsWatchKey("pilots", "1")
+	war := map[string]string{"Sol|state|WAR": "war"}
+	warAndElection := map[string]string{"Sol|state|WAR": "war", "Lave|state|ELECTION": "election"}
+
+	steps := []struct {
+		conditions map[string]string
+		messages   []string
+		changed    bool
+	}{
+		{war, []string{"war"}, true},
+		{war, []string{}, false},
+		{warAndElection, []string{"election"}, true},
+		{war, []string{}, true},
+		{map[string]string{}, []string{}, true},
+		{war, []string{"war"}, true},
+	}
+	for i, s := range steps {
+		messages, changed := w.noteConditions(key, s.conditions)
+		if !reflect.DeepEqual(messages, s.messages) || changed != s.changed {
+			t.Errorf("Step %d: expected %v %v, got %v %v", i, s.messages, s.changed, messages, changed)
+		}
+	}
+
+	if !w.removeWatch("Pilots", "1") {
+		t.Fatal("The watch is not removed")
+	}
+	if messages, changed := w.noteConditions(key, war); len(messages) != 0 || changed {
+		t.Errorf("The removed watch reports %v", messages)
+	}
+}
+
+func TestBGSWatcherStateWinsOverConfig(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "bgs.json")
+	cfg := &BGSAlertsConfig{StateFile: stateFile, Watches: []BGSWatch{
+		{Faction: "Pilots", ChannelID: "1"},
+		{Faction: "Guardians", ChannelID: "1", InfluenceAbove: 40}}}
+
+	w := newBGSWatcher(cfg, nil)
+	w.removeWatch("Pilots", "1")
+	w.addWatch(&BGSWatch{Faction: "Guardians", ChannelID: "1", InfluenceAbove: 60})
+
+	restored := newBGSWatcher(cfg, nil).listWatches()
+	if len(restored) != 1 || restored[0].Faction != "Guardians" || restored[0].InfluenceAbove != 60 {
+		t.Errorf("Unexpected watches after restart: %+v", restored)
+	}
+}
+
+func TestBGSWatcherConcurrentBackups(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "bgs.json")
+	w := newBGSWatcher(&BGSAlertsConfig{StateFile: stateFile}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			w.addWatch(&BGSWatch{Faction: fmt.Sprintf("Faction %d", i), ChannelID: "1"})
+		}(i)
+	}
+	wg.Wait()
+
+	// the last snapshot written has every watch
+	if restored := newBGSWatcher(&BGSAlertsConfig{StateFile: stateFile}, nil).listWatches(); len(restored) != 20 {
+		t.Errorf("Expected 20 watches after restart, got %d", len(restored))
+	}
+
+	w.close()
+	w.close()
+}
+
+func TestParseBGSWatch(t *testing.T) {
+	watch, err := parseBGSWatch("1", "Pilots Federation above 45.5% below 10 states War, Civil War")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &BGSWatch{Faction: "Pilots Federation", ChannelID: "1",
+		States: []string{"War", "Civil War"}, InfluenceAbove: 45.5, InfluenceBelow: 10}
+	if !reflect.DeepEqual(watch, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, watch)
+	}
+
+	for _, rest := range []string{"", "  ", "x", " states War"} {
+		if watch, err := parseBGSWatch("1", rest); err == nil {
+			t.Errorf("%q is taken as %+v", rest, watch)
+		}
+	}
+}
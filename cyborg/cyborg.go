@@ -22,6 +22,7 @@ type CyborgBotDiscordConfig struct {
 	Operators      []string
 	AutoRoles      []AssignRoleOnGame
 	IgnoredSystems []string
+	BGSAlerts      BGSAlertsConfig
 }
 
 func (c *CyborgBotDiscordConfig) CheckConfig() error {
@@ -39,6 +40,7 @@ type CybordBot struct {
 	operators    map[string]int
 	roleAssigner *role_assigner
 	t            *talker
+	bgs          *bgsWatcher
 	DgSession    *discordgo.Session
 	giClient     *edgic.EDInfoCenterClient
 }
@@ -55,12 +57,14 @@ func NewCybordBot(cfg *CyborgBotDiscordConfig, galaxyInfoAddress string) *Cybord
 		log.Println("Bot name is not set, assuming " + botName)
 	}
 
+	bgs := newBGSWatcher(&cfg.BGSAlerts, giClient)
 	b := &CybordBot{
 		Token:        cfg.Token,
 		BotName:      botName,
 		Version:      ver,
 		roleAssigner: newRoleAssigner(cfg.AutoRoles),
-		t:            newTalker(cfg.Operators, botName, ver, giClient, cfg.IgnoredSystems),
+		t:            newTalker(cfg.Operators, botName, ver, giClient, cfg.IgnoredSystems, bgs),
+		bgs:          bgs,
 		giClient:     giClient,
 	}
 	b.operators = make(map[string]int)
@@ -111,6 +115,7 @@ func (bot *CybordBot) Connect(logLevel int) (err error) {
 
 	bot.roleAssigner.start()
 	bot.t.start()
+	bot.bgs.start(dg)
 	return nil
 }
 
@@ -148,5 +153,6 @@ func (bot *CybordBot) onGuildCreate(gc *discordgo.GuildCreate) {
 func (bot *CybordBot) Close() (err error) {
 	bot.roleAssigner.close()
 	bot.t.close()
+	bot.bgs.close()
 	return bot.DgSession.Close()
 }
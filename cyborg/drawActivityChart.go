@@ -52,7 +52,7 @@ func makeYTicks(values []float64) chart.Ticks {
 
 }
 
-func makeDayTicks(stat []*edGalaxy.ActivityStatItem) chart.Ticks {
+func makeDayTicks(stat []*edGalaxy.ActivityStatItem, frame int64) chart.Ticks {
 	l := len(stat)
 	if l < 1 {
 		return nil
@@ -65,28 +65,55 @@ func makeDayTicks(stat []*edGalaxy.ActivityStatItem) chart.Ticks {
 		minTimestamp = edGalaxy.Min(minTimestamp, s.Timestamp)
 		maxTimestamp = edGalaxy.Max(maxTimestamp, s.Timestamp)
 	}
+	step, layout := tickStep(frame, maxTimestamp-minTimestamp)
+	return makeTicksInRange(minTimestamp, maxTimestamp, frame, step, layout)
+}
 
+/*
+	The ticks for the frames, by the hours for the minutes,
+	by the weeks for the days over two months and by the days otherwise
+*/
+func tickStep(frame int64, span int64) (int64, string) {
 	var secondsPerDay int64 = 24 * 60 * 60
+	switch {
+	case frame < 3600 && span > 12*3600:
+		return 3 * 3600, "15:04"
+	case frame < 3600:
+		return 3600, "15:04"
+	case span > 60*secondsPerDay:
+		return 7 * secondsPerDay, "2006-01-02"
+	}
+	return secondsPerDay, "2006-01-02"
+}
+
+func makeDayTicksInRange(minTimestamp, maxTimestamp int64) chart.Ticks {
+	return makeTicksInRange(minTimestamp, maxTimestamp, 3600, 24*60*60, "2006-01-02")
+}
 
-	firstDay := (minTimestamp + secondsPerDay - 1) / secondsPerDay
+/*
+	A tick every step, labeled by the start of the step, the last tick
+	is one frame past the last point
+*/
+func makeTicksInRange(minTimestamp, maxTimestamp, frame, step int64, layout string) chart.Ticks {
+	firstTick := (minTimestamp + step - 1) / step
 	ticks := make([]chart.Tick, 0)
 
-	dayTick := firstDay * secondsPerDay
+	tick := firstTick * step
 
-	if minTimestamp < dayTick {
+	if minTimestamp < tick {
 		ticks = append(ticks,
 			chart.Tick{Value: float64(minTimestamp), Label: ""})
 	}
-	for ; dayTick < maxTimestamp; dayTick += secondsPerDay {
+	for ; tick < maxTimestamp; tick += step {
 		ticks = append(ticks,
 			chart.Tick{
-				Value: float64(dayTick),
-				Label: time.Unix(dayTick-1, 0).UTC().Format("2006-01-02")})
+				Value: float64(tick),
+				Label: time.Unix(tick-step, 0).UTC().Format(layout)})
 	}
-	if dayTick-secondsPerDay < maxTimestamp {
+	if tick-step < maxTimestamp {
 		ticks = append(ticks,
 			chart.Tick{
-				Value: float64(maxTimestamp + 3600),
+				Value: float64(maxTimestamp + frame),
 				Label: "",
 			})
 	}
@@ -181,14 +208,45 @@ func UTCUnixTimeFormatter(v interface{}) string {
 	return tm.UTC().Format(time.RFC3339)
 }
 
-func DrawChart(stat []*edGalaxy.ActivityStatItem, out io.Writer) error {
+/*
+	The frame of the stat, the newest item first, as the server picked it for the window
+*/
+func ActivityStatFrame(stat []*edGalaxy.ActivityStatItem) int64 {
+	if len(stat) < 2 || stat[0].Timestamp <= stat[1].Timestamp {
+		return 3600
+	}
+	return stat[0].Timestamp - stat[1].Timestamp
+}
+
+/*
+	The series name per frame: Jumps/min, Jumps/h, Jumps/day
+*/
+func perFrameName(name string, frame int64) string {
+	switch frame {
+	case 60:
+		return name + "/min"
+	case 3600:
+		return name + "/h"
+	case 24 * 3600:
+		return name + "/day"
+	}
+	return fmt.Sprintf("%s/%s", name, time.Duration(frame)*time.Second)
+}
+
+/*
+	The stat is the newest item first, one per frame seconds. The current frame
+	is not drawn unless it is a day, a whole day is too much to hide.
+*/
+func DrawChart(stat []*edGalaxy.ActivityStatItem, frame int64, out io.Writer) error {
 
 	if stat == nil || len(stat) < 2 {
 		return errors.New("Insufficient data points")
 	}
 
-	stat = stat[1:] // strip last hour - could be 0 at the beginning
-	dayTicks := makeDayTicks(stat[1:])
+	if frame < 24*3600 {
+		stat = stat[1:] // strip the current frame - could be 0 at the beginning
+	}
+	dayTicks := makeDayTicks(stat, frame)
 
 	js, ds, times := makeJumpsDocksSeries(stat)
 
@@ -231,7 +289,7 @@ func DrawChart(stat []*edGalaxy.ActivityStatItem, out io.Writer) error {
 					StrokeColor: discordBlue,                // chart.ColorBlue,
 					FillColor:   discordBlue.WithAlpha(150), // chart.ColorBlue.WithAlpha(100),
 				},
-				Name:    "Jumps/h",
+				Name:    perFrameName("Jumps", frame),
 				XValues: times,
 				YValues: js},
 			chart.ContinuousSeries{
@@ -240,7 +298,7 @@ func DrawChart(stat []*edGalaxy.ActivityStatItem, out io.Writer) error {
 					StrokeColor: chart.ColorAlternateGreen,
 					FillColor:   chart.ColorAlternateGreen.WithAlpha(100),
 				},
-				Name:    "Docks/h",
+				Name:    perFrameName("Docks", frame),
 				XValues: times,
 				YValues: ds},
 		},
@@ -256,3 +314,100 @@ func DrawChart(stat []*edGalaxy.ActivityStatItem, out io.Writer) error {
 	graph.Render(chart.PNG, out)
 	return nil
 }
+
+func PercentValueFormatter(v interface{}) string {
+	switch v.(type) {
+	case float64:
+		return fmt.Sprintf("%.0f%%", v.(float64)*100)
+	default:
+		return ""
+	}
+}
+
+/*
+	A line per series, the influence is a fraction of 1
+*/
+func DrawInfluenceChart(h *edGalaxy.InfluenceHistory, out io.Writer) error {
+	if h == nil || len(h.Series) == 0 {
+		return errors.New("Insufficient data points")
+	}
+
+	var minTimestamp int64 = math.MaxInt64
+	var maxTimestamp int64 = math.MinInt64
+	series := make([]chart.Series, 0, len(h.Series))
+	for i, s := range h.Series {
+		if len(s.Points) == 0 {
+			continue
+		}
+		times := make([]float64, len(s.Points))
+		influence := make([]float64, len(s.Points))
+		for j, p := range s.Points {
+			times[j] = float64(p.Timestamp)
+			influence[j] = p.Influence
+			minTimestamp = edGalaxy.Min(minTimestamp, p.Timestamp)
+			maxTimestamp = edGalaxy.Max(maxTimestamp, p.Timestamp)
+		}
+		if len(times) == 1 {
+			// a single day is drawn as a flat line through the day
+			times = append(times, times[0]+24*60*60-1)
+			influence = append(influence, influence[0])
+			maxTimestamp = edGalaxy.Max(maxTimestamp, s.Points[0].Timestamp+24*60*60-1)
+		}
+		series = append(series, chart.ContinuousSeries{
+			Style: chart.Style{
+				Show:        true,
+				StrokeColor: chart.GetDefaultColor(i),
+				StrokeWidth: 2.0,
+			},
+			Name:    s.Name,
+			XValues: times,
+			YValues: influence})
+	}
+	if len(series) == 0 {
+		return errors.New("Insufficient data points")
+	}
+	dayTicks := makeDayTicksInRange(minTimestamp, maxTimestamp)
+
+	graph := chart.Chart{
+		Width:  900,
+		Height: 450,
+		DPI:    72,
+		Background: chart.Style{
+			Padding: chart.Box{
+				Top:    10,
+				Left:   5,
+				Right:  5,
+				Bottom: 5,
+			},
+		},
+
+		ColorPalette: activityColorPalette{},
+		XAxis: chart.XAxis{
+			Style:          chart.StyleShow(),
+			TickPosition:   chart.TickPositionBetweenTicks,
+			ValueFormatter: UTCUnixTimeFormatter,
+			Ticks:          dayTicks,
+			GridMajorStyle: chart.Style{
+				Show:            true,
+				StrokeColor:     discordWhite,
+				StrokeDashArray: []float64{5.0, 5.0},
+				StrokeWidth:     0.5,
+			},
+			GridLines: makeGridLines(dayTicks),
+		},
+		YAxis: chart.YAxis{
+			Style:          chart.StyleShow(),
+			ValueFormatter: PercentValueFormatter,
+		},
+		Series: series,
+	}
+
+	graph.Elements = []chart.Renderable{
+		chart.LegendThin(&graph,
+			chart.Style{
+				FillColor:   discordDarkGrey,
+				FontColor:   discordWhite,
+				FontSize:    9.0,
+				StrokeWidth: chart.DefaultAxisLineWidth})}
+	return graph.Render(chart.PNG, out)
+}
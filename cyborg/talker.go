@@ -13,6 +13,14 @@ import (
 	"strconv"
 	"strings"
 	"text/scanner"
+	"time"
+)
+
+const (
+	defaultMarketAgeHours       = 48
+	defaultMarketSearchDistance = 100
+	defaultInfluenceDays        = 30
+	defaultCarrierDistance      = 50
 )
 
 var (
@@ -21,6 +29,17 @@ var (
 	rePopularAtColonia   = regexp.MustCompile(`\s*at\s+colonia\s*`)
 	rePopularNear        = regexp.MustCompile(`\s*near\s*(\S.*\S)`)
 	rePopularInside      = regexp.MustCompile(`\s*inside\s*(\d+)\s*from\s+(\S.*\S)`)
+	rePopularToday       = regexp.MustCompile(`\s*\btoday\s*$`)
+	rePopularThisWeek    = regexp.MustCompile(`\s*\bthis\s+week\s*$`)
+	rePopularLast        = regexp.MustCompile(`\s*\b(?:(?:in|for|during)\s+)?(?:the\s+)?(?:last|past)\s+(?:(\d+)\s*)?(minute|hour|day|week)s?\s*$`)
+	reTrade              = regexp.MustCompile(`(?i)^\s*(\S.*\S)\s+(\d+)\s*t\s+(\d+(?:\.\d+)?)\s*ly(?:\s+pad\s+([sml]))?(?:\s+(\d+)\s*h)?\s*$`)
+	reBuy                = regexp.MustCompile(`(?i)^\s*(\S.*\S)\s+near\s+(\S.*?\S)(?:\s+pad\s+([sml]))?\s*$`)
+	reSell               = regexp.MustCompile(`(?i)^\s*(?:(\d+)\s*t\s+(?:of\s+)?)?(\S.*?\S)\s+near\s+(\S.*?\S)(?:\s+within\s+(\d+(?:\.\d+)?)\s*ly)?(?:\s+pad\s+([sml]))?\s*$`)
+	reInfluence          = regexp.MustCompile(`(?i)^\s*(in\s+)?(\S.*?\S)(?:\s+(\d+)\s*d)?\s*$`)
+	reBGSWatch           = regexp.MustCompile(`(?i)^\s*watch\s+(add|rm)\s+(\d+)\s+(\S.*\S)\s*$`)
+	reBGSThresholds      = regexp.MustCompile(`(?i)^\s*(\S.*?\S)(?:\s+above\s+(\d+(?:\.\d+)?)\s*%?)?(?:\s+below\s+(\d+(?:\.\d+)?)\s*%?)?\s*$`)
+	reCarriersNear       = regexp.MustCompile(`(?i)^\s*near\s+(\S.*?\S)(?:\s+within\s+(\d+(?:\.\d+)?)\s*ly)?\s*$`)
+	reRoute              = regexp.MustCompile(`(?i)^\s*(\S.*\S)\s*/\s*(\S.*\S)\s+(\d+(?:\.\d+)?)\s*(?:ly)?((?:\s+(?:scoopable|neutrons?))*)\s*$`)
 )
 
 type incoming_message struct {
@@ -32,6 +51,8 @@ type incoming_message struct {
 type system_distance_call_param struct {
 	name   string
 	radius float64
+	window int64  // seconds, 0 - the default
+	period string // the window for humans
 }
 
 type talker struct {
@@ -41,9 +62,10 @@ type talker struct {
 	incomingMessages chan *incoming_message
 	giClient         *edgic.EDInfoCenterClient
 	ignoredSystems   map[string]bool
+	bgs              *bgsWatcher
 }
 
-func newTalker(ops []string, botName string, ver string, giClient *edgic.EDInfoCenterClient, ignoredSystems []string) *talker {
+func newTalker(ops []string, botName string, ver string, giClient *edgic.EDInfoCenterClient, ignoredSystems []string, bgs *bgsWatcher) *talker {
 	t := &talker{
 		version:          ver,
 		botName:          botName,
@@ -51,6 +73,7 @@ func newTalker(ops []string, botName string, ver string, giClient *edgic.EDInfoC
 		incomingMessages: make(chan *incoming_message),
 		giClient:         giClient,
 		ignoredSystems:   make(map[string]bool),
+		bgs:              bgs,
 	}
 	for _, op := range ops {
 		t.operators[op] = 1
@@ -102,6 +125,18 @@ func (t *talker) handleIncomingMessage(im *incoming_message) {
 		t.handleDistanceRequest(im.s, im.m.ChannelID, ctx[9:])
 		return
 	}
+	if strings.HasPrefix(ctx, "bodies ") {
+		t.handleBodiesRequest(im.s, im.m.ChannelID, strings.TrimSpace(ctx[7:]))
+		return
+	}
+	if strings.HasPrefix(ctx, "carriers ") {
+		t.handleCarriersRequest(im.s, im.m.ChannelID, ctx[9:])
+		return
+	}
+	if strings.HasPrefix(ctx, "carrier ") {
+		t.handleCarrierRequest(im.s, im.m.ChannelID, strings.TrimSpace(ctx[8:]))
+		return
+	}
 	if strings.HasPrefix(ctx, "stations ") {
 		t.handleStationsRequest(im.s, im.m.ChannelID, ctx[9:])
 		return
@@ -110,14 +145,46 @@ func (t *talker) handleIncomingMessage(im *incoming_message) {
 		t.handleStatRequest(im.s, im.m.ChannelID, ctx[4:])
 		return
 	}
+	if strings.HasPrefix(ctx, "popular stations ") {
+		t.handlePopularStationsRequest(im.s, im.m.ChannelID, ctx[17:])
+		return
+	}
 	if strings.HasPrefix(ctx, "popular ") {
 		t.handlePopularSystemsRequest(im.s, im.m.ChannelID, ctx[8:])
 		return
 	}
+	if strings.HasPrefix(ctx, "trending ") {
+		t.handleTrendingRequest(im.s, im.m.ChannelID, ctx[9:])
+		return
+	}
 	if strings.HasPrefix(ctx, "activity ") {
 		t.handleActivityRequest(im.s, im.m.ChannelID, ctx[9:])
 		return
 	}
+	if strings.HasPrefix(ctx, "route ") {
+		t.handleRouteRequest(im.s, im.m.ChannelID, ctx[6:])
+		return
+	}
+	if strings.HasPrefix(ctx, "buy ") {
+		t.handleBuyRequest(im.s, im.m.ChannelID, ctx[4:])
+		return
+	}
+	if strings.HasPrefix(ctx, "sell ") {
+		t.handleSellRequest(im.s, im.m.ChannelID, ctx[5:])
+		return
+	}
+	if strings.HasPrefix(ctx, "trade ") {
+		t.handleTradeRequest(im.s, im.m.ChannelID, ctx[6:])
+		return
+	}
+	if strings.HasPrefix(ctx, "faction ") {
+		t.handleFactionRequest(im.s, im.m.ChannelID, strings.TrimSpace(ctx[8:]))
+		return
+	}
+	if strings.HasPrefix(ctx, "influence ") {
+		t.handleInfluenceRequest(im.s, im.m.ChannelID, ctx[10:])
+		return
+	}
 	if _, op := t.operators[im.m.Author.ID]; im.isDirect && op {
 		t.handleDirectOperatorMessage(im)
 	}
@@ -140,6 +207,8 @@ func (t *talker) handleDirectOperatorMessage(im *incoming_message) {
 		t.handleOperatorLS(im, tokens[1:])
 	case "say":
 		t.handleOperatorSay(im, tokens[1:])
+	case "watch":
+		t.handleOperatorWatch(im, tokens[1:])
 	default:
 		SendMessage(im.s, im.m.ChannelID, "Unknown command")
 		return
@@ -163,6 +232,57 @@ func (t *talker) handleOperatorSay(im *incoming_message, tokens []string) {
 	SendMessage(im.s, tokens[0], im.m.Content[stripSize:])
 }
 
+func (t *talker) handleOperatorWatch(im *incoming_message, tokens []string) {
+	if len(tokens) == 1 && strings.ToLower(tokens[0]) == "ls" {
+		watches := t.bgs.listWatches()
+		if len(watches) == 0 {
+			SendMessage(im.s, im.m.ChannelID, "No factions are watched")
+			return
+		}
+		rows := make([][]string, len(watches))
+		for i, w := range watches {
+			states := strings.Join(w.States, ", ")
+			if len(states) == 0 {
+				states = "default"
+			}
+			thresholds := ""
+			if w.InfluenceAbove > 0 {
+				thresholds += fmt.Sprintf("above %g%% ", w.InfluenceAbove)
+			}
+			if w.InfluenceBelow > 0 {
+				thresholds += fmt.Sprintf("below %g%%", w.InfluenceBelow)
+			}
+			rows[i] = []string{w.Faction, w.ChannelID, states, strings.TrimSpace(thresholds)}
+		}
+		sendTable(im.s, im.m.ChannelID, "", "llll", []string{"Faction", "Channel", "States", "Influence"}, rows)
+		return
+	}
+	mt := reBGSWatch.FindStringSubmatch(im.m.Content)
+	if mt == nil {
+		SendMessage(im.s, im.m.ChannelID, "syntax is: watch ls | watch add channelId faction [above N%] [below N%] [states s1,s2] | watch rm channelId faction")
+		return
+	}
+	if strings.ToLower(mt[1]) == "rm" {
+		if t.bgs.removeWatch(mt[3], mt[2]) {
+			SendMessage(im.s, im.m.ChannelID, fmt.Sprintf("%s is not watched for %s any more", mt[3], mt[2]))
+		} else {
+			SendMessage(im.s, im.m.ChannelID, fmt.Sprintf("%s was not watched for %s", mt[3], mt[2]))
+		}
+		return
+	}
+	w, err := parseBGSWatch(mt[2], mt[3])
+	if err != nil {
+		SendMessage(im.s, im.m.ChannelID, err.Error())
+		return
+	}
+	if _, err, suggested := t.giClient.GetFactionInfo(w.Faction); err != nil {
+		SendMessage(im.s, im.m.ChannelID, fmtErrorWithSuggestions(err, suggested))
+		return
+	}
+	t.bgs.addWatch(w)
+	SendMessage(im.s, im.m.ChannelID, fmt.Sprintf("%s is watched for %s", w.Faction, w.ChannelID))
+}
+
 func (t *talker) handleOperatorLS(im *incoming_message, tokens []string) {
 	if len(tokens) == 0 {
 		SendMessage(im.s, im.m.ChannelID, "syntax is: ls category")
@@ -233,8 +353,12 @@ func (t *talker) handleOperatorLSchannels(im *incoming_message) {
 
 func (t *talker) handleStatRequest(ds *discordgo.Session, channelID string, categories string) {
 	cat := strings.TrimSpace(categories)
+	if lcat := strings.ToLower(cat); lcat == "eddn" || strings.HasPrefix(lcat, "eddn ") {
+		t.handleEDDNStatRequest(ds, channelID, cat[4:])
+		return
+	}
 	if len(cat) != 0 && !(strings.ToLower(cat) == "humans") {
-		SendMessage(ds, channelID, "Sorry, I can only stat human's galaxy now.")
+		SendMessage(ds, channelID, "Sorry, I can only stat human's galaxy and EDDN now.")
 		return
 	}
 	info, err := t.giClient.GetHumanWorldStat()
@@ -254,20 +378,102 @@ func (t *talker) handleStatRequest(ds *discordgo.Session, channelID string, cate
 	SendMessage(ds, channelID, txt)
 }
 
+/*
+	The region is the one of popular, the galaxy if not given
+*/
+func (t *talker) handleEDDNStatRequest(ds *discordgo.Session, channelID string, rq string) {
+	lstr, window, period := cutStatPeriod(strings.ToLower(rq), time.Now())
+	p := &system_distance_call_param{name: "Sol", radius: 100000}
+	if len(strings.TrimSpace(lstr)) > 0 {
+		if p = findPopularSystemPlace(lstr); p == nil {
+			SendMessage(ds, channelID, "Sorry, i don't understand you")
+			return
+		}
+	}
+	p.window = window
+	p.period = period
+	systemName := strings.Title(p.name)
+
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+	stat, err := t.giClient.GetUploaderStat(p.name, p.radius, p.window)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+	suffix := p.periodSuffix()
+	if covered := stat.Window(); suffix == "" || covered < p.window {
+		suffix = " for " + lastStatPeriod(covered)
+	}
+	if stat.Uploaders == 0 {
+		SendMessage(ds, channelID, fmt.Sprintf("Nobody reported to EDDN inside %.1f LY from %s%s", p.radius, systemName, suffix))
+		return
+	}
+	var busiest *edGalaxy.UploaderStatItem
+	for _, h := range stat.Hours {
+		if busiest == nil || h.Uploaders > busiest.Uploaders {
+			busiest = h
+		}
+	}
+	header := fmt.Sprintf("EDDN inside %.1f LY from %s%s:\n```"+
+		"Commanders:   %s\n"+
+		"Messages:     %s\n"+
+		"Busiest hour: %s, %s commanders```\n",
+		p.radius, systemName, suffix,
+		humanize.Comma(stat.Uploaders), humanize.Comma(stat.Messages),
+		time.Unix(busiest.Timestamp, 0).UTC().Format("Jan 2 15:04 MST"), humanize.Comma(busiest.Uploaders))
+
+	title := []string{"Tool", "Cmdrs", "Messages", "%"}
+	rows := make([][]string, len(stat.Software))
+	for i, sw := range stat.Software {
+		rows[i] = []string{sw.Name, humanize.Comma(sw.Uploaders), humanize.Comma(sw.Messages),
+			fmt.Sprintf("%.1f", float64(sw.Messages)*100/float64(stat.Messages))}
+	}
+	sendTable(ds, channelID, header, "lrrr", title, rows)
+}
+
 func (t *talker) handleHelpRequest(ds *discordgo.Session, channelID string) {
 	txt := fmt.Sprintf("Ciao, I'm %s, talk module version %s.\nKnown to me commands are:```", t.botName, t.version) +
 		"system <system name>\n" +
 		"\tGives a brief system description\n" +
 		"stations <system name>\n" +
 		"\tLists the stations in the system\n" +
+		"bodies <system name>\n" +
+		"\tLists the bodies scanned by the commanders\n" +
+		"carriers near <system name> [within <N>ly]\n" +
+		"\tLists the fleet carriers seen within 50 L.Y.\n" +
+		"carrier <callsign>\n" +
+		"\tTells where the fleet carrier was seen the last time\n" +
 		"distance <system name 1>/<system name 2>\n" +
 		"\tCalculates distance between the systems\n" +
+		"route <system name 1>/<system name 2> <jump range> [scoopable] [neutron]\n" +
+		"\tPlans a trip through the populated systems\n" +
+		"\tscoopable - stop only at the scoopable stars\n" +
+		"\tneutron   - supercharge at the neutron stars\n" +
+		"buy <commodity> near <system name> [pad S|M|L]\n" +
+		"\tLists the markets selling the commodity within 100 L.Y.\n" +
+		"sell [<tonnage>t [of]] <commodity> near <system name> [within <N>ly] [pad S|M|L]\n" +
+		"\tFinds where the cargo pays most, e.g. sell 720t of Painite near Sol within 60ly\n" +
+		"trade <system name> <cargo>t <jump range>ly [pad S|M|L] [<max market age>h]\n" +
+		"\tFinds the best trades starting within a jump, e.g. trade Sol 256t 20ly pad L 24h\n" +
+		"faction <faction name>\n" +
+		"\tDescribes the faction and lists the systems it is present in\n" +
+		"influence <faction name> [<N>d]\n" +
+		"\tDraws the faction influence in its systems for the last N (30) days\n" +
+		"influence in <system name> [<N>d]\n" +
+		"\tDraws the influence of the factions in the system\n" +
 		"stat humans\n" +
 		"\tGives some numbers about the galaxy\n" +
-		"[popular|activity] ...\n" +
-		"\tpopular  - Collects system visit counts\n" +
-		"\tactivity - Draws jumps/h and docks/h\n" +
-		"\tBoth popular and activity accept:\n" +
+		"stat eddn [<region as for popular>] [<period>]\n" +
+		"\tCounts the commanders reporting to EDDN and their tools, the galaxy for the last day by default\n" +
+		"[popular|popular stations|trending|activity] ...\n" +
+		"\tpopular          - Collects system visit counts\n" +
+		"\tpopular stations - Lists the busiest stations and carriers by docks\n" +
+		"\ttrending         - Lists the systems visited far above their usual\n" +
+		"\tactivity         - Draws jumps/h and docks/h\n" +
+		"\tAll of them accept:\n" +
 		"\t\t--- inside <num L.Y.> from <system name>\n" +
 		"\t\t--- near <system name>\n" +
 		"\t\t\tA shortcut to --- inside 100 from <system name>\n" +
@@ -277,6 +483,9 @@ func (t *talker) handleHelpRequest(ds *discordgo.Session, channelID string) {
 		"\t\t\tMeans --- inside 500 from Colonia\n" +
 		"\t\t--- in the galaxy\n" +
 		"\t\t\tMeans --- inside 100,000 from Sol\n" +
+		"\tAnd may end with a period, popular stations take the last day if none, trending the last 3 hours:\n" +
+		"\t\t--- today, --- this week\n" +
+		"\t\t--- [in the] last [N] minutes|hours|days|weeks\n" +
 		"```"
 
 	SendMessage(ds, channelID, txt)
@@ -318,6 +527,16 @@ func (t *talker) handleSystemRequest(ds *discordgo.Session, channelID string, sy
 			s.BriefInfo.Allegiance,
 			s.BriefInfo.FactionState)
 	}
+	if s.PrimaryStar != nil && s.PrimaryStar.Type != "" {
+		scoopable := "not scoopable"
+		if s.PrimaryStar.IsScoopable {
+			scoopable = "scoopable"
+		}
+		txt += fmt.Sprintf("Primary star:      %s (%s)\n", s.PrimaryStar.Type, scoopable)
+	}
+	if s.LastEDDNUpdate > 0 {
+		txt += fmt.Sprintf("Seen via EDDN:     %s\n", humanize.Time(time.Unix(s.LastEDDNUpdate, 0)))
+	}
 
 	SendMessage(ds, channelID, txt+"```")
 }
@@ -373,8 +592,91 @@ func getVisitedSystemsTable(stat []*edGalaxy.SystemVisitsStatCalculated, total i
 	return rows, mxLen[0], mxLen[1], mxLen[2], mxLen[3]
 }
 
+var statPeriodUnits = map[string]int64{
+	"minute": 60,
+	"hour":   3600,
+	"day":    24 * 3600,
+	"week":   7 * 24 * 3600}
+
+// the stat is not kept for longer
+const maxStatPeriod = 365 * 24 * 3600
+
+/*
+	Cuts the trailing period (today, this week, last N hours/days/weeks) off the request.
+	Today and this week start at the UTC midnight and Monday as the game clock does.
+	N is taken as 1 if 0 and is cut down to a year.
+*/
+func cutStatPeriod(lstr string, now time.Time) (string, int64, string) {
+	now = now.UTC()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if loc := rePopularToday.FindStringIndex(lstr); loc != nil {
+		return lstr[:loc[0]], int64(now.Sub(midnight).Seconds()) + 1, "today"
+	}
+	if loc := rePopularThisWeek.FindStringIndex(lstr); loc != nil {
+		monday := midnight.AddDate(0, 0, -((int(now.Weekday()) + 6) % 7))
+		return lstr[:loc[0]], int64(now.Sub(monday).Seconds()) + 1, "this week"
+	}
+	mt := rePopularLast.FindStringSubmatchIndex(lstr)
+	if mt == nil {
+		return lstr, 0, ""
+	}
+	unit := lstr[mt[4]:mt[5]]
+	maxN := maxStatPeriod / statPeriodUnits[unit]
+	n := int64(1)
+	if mt[2] >= 0 {
+		var err error
+		if n, err = strconv.ParseInt(lstr[mt[2]:mt[3]], 10, 64); err != nil || n > maxN {
+			n = maxN
+		}
+		if n < 1 {
+			n = 1
+		}
+	}
+	period := "the last " + unit
+	if n > 1 {
+		period = fmt.Sprintf("the last %d %ss", n, unit)
+	}
+	return lstr[:mt[0]], n * statPeriodUnits[unit], period
+}
+
+/*
+	The period of the window in the largest whole units: 7 days -> "the last week"
+*/
+func lastStatPeriod(window int64) string {
+	for _, unit := range []string{"week", "day", "hour"} {
+		size := statPeriodUnits[unit]
+		if n := window / size; n > 0 && window%size == 0 {
+			if n == 1 {
+				return "the last " + unit
+			}
+			return fmt.Sprintf("the last %d %ss", n, unit)
+		}
+	}
+	return fmt.Sprintf("the last %d minutes", window/60)
+}
+
+func (p *system_distance_call_param) periodSuffix() string {
+	if p.period == "" {
+		return ""
+	}
+	if p.period == "today" {
+		return " today"
+	}
+	return " for " + p.period
+}
+
 func findPopularSystemParam(rqString string) *system_distance_call_param {
-	lcrq := []byte(strings.ToLower(rqString))
+	lstr, window, period := cutStatPeriod(strings.ToLower(rqString), time.Now())
+	p := findPopularSystemPlace(lstr)
+	if p != nil {
+		p.window = window
+		p.period = period
+	}
+	return p
+}
+
+func findPopularSystemPlace(lstr string) *system_distance_call_param {
+	lcrq := []byte(lstr)
 	if rePopularInTheBuble.Match(lcrq) {
 		return &system_distance_call_param{name: "Sol", radius: 1000}
 	}
@@ -384,7 +686,6 @@ func findPopularSystemParam(rqString string) *system_distance_call_param {
 	if rePopularAtColonia.Match(lcrq) {
 		return &system_distance_call_param{name: "Colonia", radius: 500}
 	}
-	lstr := strings.ToLower(rqString)
 	mt := rePopularNear.FindAllStringSubmatch(lstr, 1)
 	if mt != nil && len(mt) > 0 {
 		if mt[0] != nil && len(mt[0]) == 2 {
@@ -424,7 +725,7 @@ func (t *talker) handleActivityRequest(ds *discordgo.Session, channelID string,
 		return
 	}
 
-	stat, err := t.giClient.GetGalaxyActivityStat(p.name, p.radius)
+	stat, err := t.giClient.GetGalaxyActivityStat(p.name, p.radius, p.window)
 	if err != nil {
 		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
 		return
@@ -436,12 +737,69 @@ func (t *talker) handleActivityRequest(ds *discordgo.Session, channelID string,
 
 	pngBuffer := &bytes.Buffer{}
 
-	DrawChart(stat, pngBuffer)
+	DrawChart(stat, ActivityStatFrame(stat), pngBuffer)
 	fileName := "galaxyActivity.png"
 
 	ms := &discordgo.MessageSend{
 		Embed: &discordgo.MessageEmbed{
-			Description: fmt.Sprintf("Activity inside %.1f LY from %s\n", p.radius, systemName),
+			Description: fmt.Sprintf("Activity inside %.1f LY from %s%s\n", p.radius, systemName, p.periodSuffix()),
+			Image: &discordgo.MessageEmbedImage{
+				URL: "attachment://" + fileName,
+			},
+		},
+		Files: []*discordgo.File{
+			&discordgo.File{
+				Name:   fileName,
+				Reader: pngBuffer,
+			},
+		},
+	}
+	ds.ChannelMessageSendComplex(channelID, ms)
+}
+
+func (t *talker) handleInfluenceRequest(ds *discordgo.Session, channelID string, request string) {
+	mt := reInfluence.FindStringSubmatch(request)
+	if mt == nil {
+		SendMessage(ds, channelID, "Sorry, i don't understand you. Try influence <faction name> [<N>d] or influence in <system name> [<N>d]")
+		return
+	}
+	days := defaultInfluenceDays
+	if len(mt[3]) > 0 {
+		days, _ = strconv.Atoi(mt[3])
+	}
+	inSystem := len(mt[1]) > 0
+	name := mt[2]
+
+	var h *edGalaxy.InfluenceHistory
+	var err error
+	if inSystem {
+		if errmsg := t.chkSystemName(name); errmsg != "" {
+			SendMessage(ds, channelID, errmsg)
+			return
+		}
+		h, err = t.giClient.GetSystemInfluenceHistory(name, days)
+	} else {
+		h, err = t.giClient.GetFactionInfluenceHistory(name, days)
+	}
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+
+	pngBuffer := &bytes.Buffer{}
+	if err = DrawInfluenceChart(h, pngBuffer); err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("Not enough data to tell something about %s", name))
+		return
+	}
+	fileName := "influence.png"
+
+	description := fmt.Sprintf("%s influence for the last %d days\n", h.Name, days)
+	if inSystem {
+		description = fmt.Sprintf("Faction influence in %s for the last %d days\n", h.Name, days)
+	}
+	ms := &discordgo.MessageSend{
+		Embed: &discordgo.MessageEmbed{
+			Description: description,
 			Image: &discordgo.MessageEmbedImage{
 				URL: "attachment://" + fileName,
 			},
@@ -471,16 +829,16 @@ func (t *talker) handlePopularSystemsRequest(ds *discordgo.Session, channelID st
 		return
 	}
 
-	stat, total, err := t.giClient.GetMostVisitedSystems(p.name, p.radius, 20)
+	stat, total, err := t.giClient.GetMostVisitedSystems(p.name, p.radius, p.window, 20)
 	if err != nil {
 		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
 		return
 	}
 	if total == 0 {
-		SendMessage(ds, channelID, fmt.Sprintf("I didn't see any mention of %s in the social media", systemName))
+		SendMessage(ds, channelID, fmt.Sprintf("I didn't see any mention of %s in the social media%s", systemName, p.periodSuffix()))
 		return
 	}
-	txt := fmt.Sprintf("I know about %s visit(s) inside %.1f LY from %s\n", humanize.Comma(total), p.radius, systemName)
+	txt := fmt.Sprintf("I know about %s visit(s) inside %.1f LY from %s%s\n", humanize.Comma(total), p.radius, systemName, p.periodSuffix())
 
 	rows, mxNameLen, mxVisitsLen, mxVisitsPersLen, mxDistLen := getVisitedSystemsTable(stat, total)
 
@@ -510,6 +868,200 @@ func (t *talker) handlePopularSystemsRequest(ds *discordgo.Session, channelID st
 	SendMessage(ds, channelID, txt)
 }
 
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+/*
+	The dock counts as bars, the oldest first
+*/
+func fmtDocksSparkline(docks []*edGalaxy.ActivityStatItem) string {
+	var mx int64 = 0
+	for _, d := range docks {
+		if d.NumDocks > mx {
+			mx = d.NumDocks
+		}
+	}
+	bars := make([]rune, len(docks))
+	for i, d := range docks {
+		level := 0
+		if mx > 0 {
+			level = int(d.NumDocks * int64(len(sparkLevels)-1) / mx)
+		}
+		bars[len(docks)-1-i] = sparkLevels[level]
+	}
+	return string(bars)
+}
+
+func (t *talker) handlePopularStationsRequest(ds *discordgo.Session, channelID string, rq string) {
+	p := findPopularSystemParam(rq)
+	if p == nil {
+		SendMessage(ds, channelID, "Sorry, i don't understand you")
+		return
+	}
+
+	systemName := strings.Title(p.name)
+
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+
+	stat, err := t.giClient.GetStationActivity(p.name, p.radius, p.window, 20)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+	period := p.periodSuffix()
+	if period == "" {
+		period = " for the last day"
+	}
+	if len(stat) == 0 {
+		SendMessage(ds, channelID, fmt.Sprintf("Nobody docked inside %.1f LY from %s%s", p.radius, systemName, period))
+		return
+	}
+	title := []string{"Station", "System", "Docks", "L.Y.", "Trend"}
+	rows := make([][]string, len(stat))
+	for i, s := range stat {
+		rows[i] = []string{s.Name, s.SystemName, humanize.Comma(s.NumDocks), fmt.Sprintf("%.2f", s.Distance), fmtDocksSparkline(s.Docks)}
+	}
+	header := fmt.Sprintf("The busiest stations inside %.1f LY from %s%s:\n", p.radius, systemName, period)
+	sendTable(ds, channelID, header, "llrrl", title, rows)
+}
+
+func (t *talker) handleTrendingRequest(ds *discordgo.Session, channelID string, rq string) {
+	p := findPopularSystemParam(rq)
+	if p == nil {
+		SendMessage(ds, channelID, "Sorry, i don't understand you")
+		return
+	}
+
+	systemName := strings.Title(p.name)
+
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+
+	trending, err := t.giClient.GetTrendingSystems(p.name, p.radius, p.window, 20)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+	period := p.periodSuffix()
+	if period == "" {
+		period = " for the last 3 hours"
+	}
+	if len(trending) == 0 {
+		SendMessage(ds, channelID, fmt.Sprintf("Nothing unusual inside %.1f LY from %s%s", p.radius, systemName, period))
+		return
+	}
+	title := []string{"System", "Visits", "/h", "Usual /h", "Score", "L.Y."}
+	rows := make([][]string, len(trending))
+	for i, s := range trending {
+		rows[i] = []string{s.Name, humanize.Comma(s.Visits), fmt.Sprintf("%.1f", s.Rate), fmt.Sprintf("%.1f", s.BaselineRate),
+			fmt.Sprintf("%.1f", s.Score), fmt.Sprintf("%.2f", s.Distance)}
+	}
+	header := fmt.Sprintf("Trending inside %.1f LY from %s%s, the score is in the standard deviations above the last week:\n", p.radius, systemName, period)
+	sendTable(ds, channelID, header, "lrrrrr", title, rows)
+}
+
+func fmtErrorWithSuggestions(err error, suggested []string) string {
+	txt := fmt.Sprintf("%v", err)
+	if len(suggested) > 1 {
+		txt += "\nDid you mean one of the following?```\n"
+		for _, sg := range suggested {
+			txt += sg + "\n"
+		}
+		txt += "```\n"
+	} else if len(suggested) == 1 {
+		txt += "\nDid you mean " + suggested[0] + "?\n"
+	}
+	return txt
+}
+
+func (t *talker) handleBodiesRequest(ds *discordgo.Session, channelID string, systemName string) {
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+	bodies, err := t.giClient.GetSystemBodies(systemName)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+	title := []string{"Distance", "Name", "Type", "Notes"}
+	rows := make([][]string, len(bodies))
+	for i, b := range bodies {
+		notes := make([]string, 0)
+		if b.Landable {
+			notes = append(notes, "landable")
+		}
+		if len(b.TerraformState) > 0 {
+			notes = append(notes, b.TerraformState)
+		}
+		if len(b.RingClasses) > 0 {
+			notes = append(notes, strings.Join(b.RingClasses, "/")+" rings")
+		}
+		if !b.WasDiscovered {
+			notes = append(notes, "first discovery")
+		}
+		rows[i] = []string{humanize.CommafWithDigits(b.DistanceFromArrival, 0) + " ls", b.Name, b.Type, strings.Join(notes, ", ")}
+	}
+	sendTable(ds, channelID, fmt.Sprintf("Bodies scanned in %s:\n", strings.Title(systemName)), "rlll", title, rows)
+}
+
+func (t *talker) handleCarriersRequest(ds *discordgo.Session, channelID string, rq string) {
+	mt := reCarriersNear.FindStringSubmatch(rq)
+	if mt == nil {
+		SendMessage(ds, channelID, "Expected: carriers near <system name> [within <N>ly]")
+		return
+	}
+	systemName := mt[1]
+	maxDistance := float64(defaultCarrierDistance)
+	if len(mt[2]) > 0 {
+		var err error
+		maxDistance, err = strconv.ParseFloat(mt[2], 64)
+		if err != nil || maxDistance <= 0 {
+			SendMessage(ds, channelID, "Distance must be a positive number")
+			return
+		}
+	}
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+	carriers, err := t.giClient.GetCarriersNear(systemName, maxDistance, 20)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+	if len(carriers) == 0 {
+		SendMessage(ds, channelID, fmt.Sprintf("No fleet carriers were seen near %s", systemName))
+		return
+	}
+	now := time.Now().Unix()
+	title := []string{"L.Y.", "Callsign", "System", "Seen"}
+	rows := make([][]string, len(carriers))
+	for i, c := range carriers {
+		rows[i] = []string{fmt.Sprintf("%.2f", c.Distance), c.Callsign, c.System, fmtMarketAge(now - c.LastSeen)}
+	}
+	header := fmt.Sprintf("Fleet carriers within %s LY from %s:\n", humanize.CommafWithDigits(maxDistance, 2), systemName)
+	sendTable(ds, channelID, header, "rllr", title, rows)
+}
+
+func (t *talker) handleCarrierRequest(ds *discordgo.Session, channelID string, callsign string) {
+	c, err := t.giClient.GetCarrier(callsign)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+	txt := fmt.Sprintf("Fleet carrier **%s** was seen in **%s** %s ago",
+		strings.ToUpper(c.Callsign), c.System, fmtMarketAge(time.Now().Unix()-c.LastSeen))
+	if len(c.Services) > 0 {
+		txt += "\nServices: " + strings.Join(c.Services, ", ")
+	}
+	SendMessage(ds, channelID, txt)
+}
+
 func (t *talker) handleStationsRequest(ds *discordgo.Session, channelID string, systemName string) {
 
 	if errmsg := t.chkSystemName(systemName); errmsg != "" {
@@ -520,21 +1072,7 @@ func (t *talker) handleStationsRequest(ds *discordgo.Session, channelID string,
 	s, err, suggested := t.giClient.GetDockableStations(systemName)
 
 	if err != nil {
-		txt := fmt.Sprintf("%v", err)
-		if suggested != nil {
-			if len(suggested) > 0 {
-				if len(suggested) > 1 {
-					txt += "\nDid you mean one of the following?```\n"
-					for _, sg := range suggested {
-						txt += sg + "\n"
-					}
-					txt += "```\n"
-				} else {
-					txt += "\nDid you mean " + suggested[0] + "?\n"
-				}
-			}
-		}
-		SendMessage(ds, channelID, txt)
+		SendMessage(ds, channelID, fmtErrorWithSuggestions(err, suggested))
 		return
 	}
 
@@ -589,6 +1127,399 @@ func (t *talker) handleDistanceRequest(ds *discordgo.Session, channelID string,
 	SendMessage(ds, channelID, txt)
 }
 
+func (t *talker) handleRouteRequest(ds *discordgo.Session, channelID string, rq string) {
+	mt := reRoute.FindStringSubmatch(rq)
+	if mt == nil {
+		SendMessage(ds, channelID, "Expected: route <system name 1>/<system name 2> <jump range> [scoopable] [neutron]")
+		return
+	}
+	from, to := mt[1], mt[2]
+	jumpRange, err := strconv.ParseFloat(mt[3], 64)
+	if err != nil || jumpRange < 1 {
+		SendMessage(ds, channelID, "Jump range must be a positive number")
+		return
+	}
+
+	if errmsg := t.chkSystemName(from); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+	if errmsg := t.chkSystemName(to); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+
+	opts := &edGalaxy.RouteOptions{}
+	for _, o := range strings.Fields(mt[4]) {
+		if strings.ToLower(o) == "scoopable" {
+			opts.ScoopableOnly = true
+		} else {
+			opts.UseNeutrons = true
+		}
+	}
+
+	route, err := t.giClient.GetRoute(from, to, jumpRange, opts)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+
+	txt := fmt.Sprintf("Route %s/%s with %s LY jumps: %d jump(s), %s LY\n",
+		from, to, humanize.CommafWithDigits(jumpRange, 2), route.Jumps, humanize.CommafWithDigits(route.TotalDistance, 2))
+
+	mxNameLen := 6 //"System"
+	for _, w := range route.Waypoints {
+		if len(w.Name) > mxNameLen {
+			mxNameLen = len(w.Name)
+		}
+	}
+	fmtStr := fmt.Sprintf("%%4s  %%-%ds  %%8s  %%9s  %%s\n", mxNameLen)
+
+	txt += "```\n"
+	txt += fmt.Sprintf(fmtStr, "#", "System", "Jump", "Total", "Notes")
+	total := 0.0
+	for i, w := range route.Waypoints {
+		total += w.Distance
+		notes := make([]string, 0, 3)
+		if w.Supercharged {
+			notes = append(notes, "supercharged")
+		}
+		if w.PrimaryStar.IsNeutronStar() {
+			notes = append(notes, "neutron")
+		} else if w.PrimaryStar != nil && w.PrimaryStar.IsScoopable {
+			notes = append(notes, "scoopable")
+		}
+		txt += fmt.Sprintf(fmtStr, strconv.Itoa(i), w.Name, fmt.Sprintf("%.2f", w.Distance), fmt.Sprintf("%.2f", total), strings.Join(notes, ", "))
+		if (i+1)%24 == 0 && i+1 < len(route.Waypoints) {
+			txt += "```"
+			SendMessage(ds, channelID, txt)
+			txt = "```\n"
+		}
+	}
+	txt += "```\n"
+	SendMessage(ds, channelID, txt)
+}
+
+func fmtMarketAge(seconds int64) string {
+	if seconds < 3600 {
+		return fmt.Sprintf("%dm", seconds/60)
+	}
+	return fmt.Sprintf("%dh", seconds/3600)
+}
+
+func (t *talker) handleBuyRequest(ds *discordgo.Session, channelID string, rq string) {
+	mt := reBuy.FindStringSubmatch(rq)
+	if mt == nil {
+		SendMessage(ds, channelID, "Expected: buy <commodity> near <system name> [pad S|M|L]")
+		return
+	}
+	commodity, systemName := mt[1], mt[2]
+	minPad := "S"
+	if len(mt[3]) > 0 {
+		minPad = strings.ToUpper(mt[3])
+	}
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+
+	points, err, suggested := t.giClient.FindCommodity(&edGalaxy.CommoditySearch{
+		Commodity:      commodity,
+		Origin:         systemName,
+		MinSupply:      1,
+		MinPad:         minPad,
+		AllowPlanetary: true,
+		MaxDistance:    defaultMarketSearchDistance,
+		MaxMarketAge:   defaultMarketAgeHours * 3600,
+		Limit:          20})
+	if err != nil {
+		SendMessage(ds, channelID, fmtErrorWithSuggestions(err, suggested))
+		return
+	}
+	if len(points) == 0 {
+		SendMessage(ds, channelID, fmt.Sprintf("Nobody sells %s near %s", commodity, systemName))
+		return
+	}
+
+	title := []string{"L.Y.", "Station", "Pad", "Price", "Supply", "Age", "Src"}
+	rows := make([][]string, len(points))
+	for i, p := range points {
+		pad := p.LandingPad
+		if p.Planetary {
+			pad += ", Planetary"
+		}
+		rows[i] = []string{fmt.Sprintf("%.2f", p.Distance), fmtTradePlace(p.Station, p.System), pad,
+			humanize.Comma(int64(p.BuyPrice)), humanize.Comma(int64(p.Supply)), fmtMarketAge(p.MarketAge), p.PriceSource}
+	}
+	sendTable(ds, channelID, fmt.Sprintf("%s near %s, pad %s:\n", commodity, systemName, minPad), "rllrrrl", title, rows)
+}
+
+func (t *talker) handleSellRequest(ds *discordgo.Session, channelID string, rq string) {
+	mt := reSell.FindStringSubmatch(rq)
+	if mt == nil {
+		SendMessage(ds, channelID, "Expected: sell [<tonnage>t [of]] <commodity> near <system name> [within <N>ly] [pad S|M|L]")
+		return
+	}
+	tonnage := 1
+	if len(mt[1]) > 0 {
+		var err error
+		tonnage, err = strconv.Atoi(mt[1])
+		if err != nil || tonnage < 1 {
+			SendMessage(ds, channelID, "Tonnage must be a positive number")
+			return
+		}
+	}
+	commodity, systemName := mt[2], mt[3]
+	maxDistance := float64(defaultMarketSearchDistance)
+	if len(mt[4]) > 0 {
+		var err error
+		maxDistance, err = strconv.ParseFloat(mt[4], 64)
+		if err != nil || maxDistance <= 0 {
+			SendMessage(ds, channelID, "Distance must be a positive number")
+			return
+		}
+	}
+	minPad := "S"
+	if len(mt[5]) > 0 {
+		minPad = strings.ToUpper(mt[5])
+	}
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+
+	points, err, suggested := t.giClient.FindSellPlaces(&edGalaxy.CommoditySearch{
+		Commodity:      commodity,
+		Origin:         systemName,
+		Tonnage:        tonnage,
+		MinPad:         minPad,
+		AllowPlanetary: true,
+		MaxDistance:    maxDistance,
+		MaxMarketAge:   defaultMarketAgeHours * 3600,
+		Limit:          20})
+	if err != nil {
+		SendMessage(ds, channelID, fmtErrorWithSuggestions(err, suggested))
+		return
+	}
+	if len(points) == 0 {
+		SendMessage(ds, channelID, fmt.Sprintf("Nobody buys %s near %s", commodity, systemName))
+		return
+	}
+
+	title := []string{"L.Y.", "Station", "Pad", "Price", "Demand", "Credits", "Age", "Src"}
+	rows := make([][]string, len(points))
+	for i, p := range points {
+		pad := p.LandingPad
+		if p.Planetary {
+			pad += ", Planetary"
+		}
+		sold := tonnage
+		if p.Demand < sold {
+			sold = p.Demand
+		}
+		rows[i] = []string{fmt.Sprintf("%.2f", p.Distance), fmtTradePlace(p.Station, p.System), pad,
+			humanize.Comma(int64(p.SellPrice)), humanize.Comma(int64(p.Demand)),
+			humanize.Comma(int64(sold) * int64(p.SellPrice)), fmtMarketAge(p.MarketAge), p.PriceSource}
+	}
+	header := fmt.Sprintf("Selling %dt of %s within %s LY from %s, pad %s:\n",
+		tonnage, commodity, humanize.CommafWithDigits(maxDistance, 2), systemName, minPad)
+	sendTable(ds, channelID, header, "rllrrrrl", title, rows)
+}
+
+/*
+	Sends a table in the code blocks, align has 'l' or 'r' per column
+*/
+func sendTable(ds *discordgo.Session, channelID string, header string, align string, title []string, rows [][]string) {
+	mxLen := make([]int, len(title))
+	for _, row := range append(rows, title) {
+		for j, txt := range row {
+			if len(txt) > mxLen[j] {
+				mxLen[j] = len(txt)
+			}
+		}
+	}
+	fmtRow := func(row []string) string {
+		cols := make([]string, len(row))
+		for j, txt := range row {
+			if align[j] == 'l' {
+				cols[j] = fmt.Sprintf("%-*s", mxLen[j], txt)
+			} else {
+				cols[j] = fmt.Sprintf("%*s", mxLen[j], txt)
+			}
+		}
+		return strings.TrimRight(strings.Join(cols, "  "), " ") + "\n"
+	}
+
+	txt := header + "```\n" + fmtRow(title)
+	for i, row := range rows {
+		txt += fmtRow(row)
+		if (i+1)%24 == 0 && i+1 < len(rows) {
+			txt += "```"
+			SendMessage(ds, channelID, txt)
+			txt = "```\n"
+		}
+	}
+	txt += "```\n"
+	SendMessage(ds, channelID, txt)
+}
+
+func (t *talker) handleFactionRequest(ds *discordgo.Session, channelID string, factionName string) {
+	if len(factionName) < 2 {
+		SendMessage(ds, channelID, "Faction name must be at least 2 chars")
+		return
+	}
+
+	f, err, suggested := t.giClient.GetFactionInfo(factionName)
+	if err != nil && len(suggested) == 1 {
+		// the only similar name is surely the one
+		f, err, suggested = t.giClient.GetFactionInfo(suggested[0])
+	}
+	if err != nil {
+		SendMessage(ds, channelID, fmtErrorWithSuggestions(err, suggested))
+		return
+	}
+
+	header := fmt.Sprintf("**%s**\nGovernment: %s\nAllegiance: %s\nState: %s\n", f.Name, f.Government, f.Allegiance, f.State)
+	if len(f.HomeSystem) > 0 {
+		header += fmt.Sprintf("Home system: %s\n", f.HomeSystem)
+	}
+	if f.IsPlayerFaction {
+		header += "Player faction\n"
+	}
+	if f.Updated > 0 {
+		header += fmt.Sprintf("Updated: %s\n", humanize.Time(time.Unix(f.Updated, 0)))
+	}
+	if len(f.Presences) == 0 {
+		SendMessage(ds, channelID, header+"Not present in any system\n")
+		return
+	}
+
+	title := []string{"System", "Influence", "State", "Controls"}
+	rows := make([][]string, len(f.Presences))
+	for i, p := range f.Presences {
+		controls := ""
+		if p.IsControlling {
+			controls = "yes"
+		}
+		rows[i] = []string{p.SystemName, fmt.Sprintf("%.1f%%", p.Influence*100), p.State, controls}
+	}
+	sendTable(ds, channelID, header, "lrll", title, rows)
+}
+
+func fmtTradePlace(station, system string) string {
+	return fmt.Sprintf("%s (%s)", station, system)
+}
+
+func (t *talker) handleTradeRequest(ds *discordgo.Session, channelID string, rq string) {
+	mt := reTrade.FindStringSubmatch(rq)
+	if mt == nil {
+		SendMessage(ds, channelID, "Expected: trade <system name> <cargo>t <jump range>ly [pad S|M|L] [<max market age>h]")
+		return
+	}
+	systemName := mt[1]
+	capacity, err := strconv.Atoi(mt[2])
+	if err != nil || capacity < 1 {
+		SendMessage(ds, channelID, "Cargo capacity must be a positive number")
+		return
+	}
+	jumpRange, err := strconv.ParseFloat(mt[3], 64)
+	if err != nil || jumpRange < 1 {
+		SendMessage(ds, channelID, "Jump range must be a positive number")
+		return
+	}
+	minPad := "S"
+	if len(mt[4]) > 0 {
+		minPad = strings.ToUpper(mt[4])
+	}
+	maxAgeHours := int64(defaultMarketAgeHours)
+	if len(mt[5]) > 0 {
+		maxAgeHours, err = strconv.ParseInt(mt[5], 10, 64)
+		if err != nil || maxAgeHours < 1 {
+			SendMessage(ds, channelID, "Market age must be a positive number of hours")
+			return
+		}
+	}
+
+	if errmsg := t.chkSystemName(systemName); errmsg != "" {
+		SendMessage(ds, channelID, errmsg)
+		return
+	}
+
+	routes, err := t.giClient.FindTradeRoutes(systemName, capacity, jumpRange, minPad, maxAgeHours*3600, 10)
+	if err != nil {
+		SendMessage(ds, channelID, fmt.Sprintf("%v", err))
+		return
+	}
+	if len(routes.Hops) == 0 {
+		SendMessage(ds, channelID, fmt.Sprintf("No profitable trades near %s", systemName))
+		return
+	}
+
+	rows := make([][]string, 0, len(routes.Hops)+len(routes.RoundTrips)*2)
+	for _, h := range routes.Hops {
+		rows = append(rows, []string{h.Commodity,
+			fmtTradePlace(h.FromStation, h.FromSystem), fmtTradePlace(h.ToStation, h.ToSystem),
+			humanize.Comma(int64(h.ProfitPerTon())), strconv.Itoa(h.Units), humanize.Comma(h.Profit)})
+	}
+	nHops := len(rows)
+	for _, l := range routes.RoundTrips {
+		for _, h := range []*edGalaxy.TradeHop{l.Outbound, l.Inbound} {
+			rows = append(rows, []string{h.Commodity,
+				fmtTradePlace(h.FromStation, h.FromSystem), fmtTradePlace(h.ToStation, h.ToSystem),
+				humanize.Comma(int64(h.ProfitPerTon())), strconv.Itoa(h.Units), humanize.Comma(l.Profit)})
+		}
+	}
+	title := []string{"Commodity", "From", "To", "Cr/t", "Units", "Profit"}
+	mxLen := make([]int, len(title))
+	for _, row := range append(rows, title) {
+		for j, txt := range row {
+			if len(txt) > mxLen[j] {
+				mxLen[j] = len(txt)
+			}
+		}
+	}
+	fmtStr := fmt.Sprintf("%%-%ds  %%-%ds  %%-%ds  %%%ds  %%%ds  %%%ds\n", mxLen[0], mxLen[1], mxLen[2], mxLen[3], mxLen[4], mxLen[5])
+	fmtRow := func(row []string) string {
+		return fmt.Sprintf(fmtStr, row[0], row[1], row[2], row[3], row[4], row[5])
+	}
+
+	txt := fmt.Sprintf("Best trades near %s for %dt, %s LY jumps, pad %s, markets up to %dh old\n",
+		systemName, capacity, humanize.CommafWithDigits(jumpRange, 2), minPad, maxAgeHours)
+	txt += "Single hops:\n```\n" + fmtRow(title)
+	for i, row := range rows[:nHops] {
+		txt += fmtRow(row)
+		if (i+1)%24 == 0 && i+1 < nHops {
+			txt += "```"
+			SendMessage(ds, channelID, txt)
+			txt = "```\n"
+		}
+	}
+	txt += "```\n"
+	if len(routes.RoundTrips) == 0 {
+		txt += "No profitable round trips\n"
+		SendMessage(ds, channelID, txt)
+		return
+	}
+	SendMessage(ds, channelID, txt)
+
+	// the loop profit is repeated on both legs
+	txt = "Round trips:\n```\n" + fmtRow(title)
+	for i, row := range rows[nHops:] {
+		txt += fmtRow(row)
+		if i%2 == 1 && i+1 < len(rows)-nHops {
+			if (i+1)%24 == 0 {
+				txt += "```"
+				SendMessage(ds, channelID, txt)
+				txt = "```\n"
+			} else {
+				txt += "\n"
+			}
+		}
+	}
+	txt += "```\n"
+	SendMessage(ds, channelID, txt)
+}
+
 func (t *talker) onMessageCreate(s *discordgo.Session, m *discordgo.MessageCreate) {
 
 	// Ignore all messages created by the bot itself
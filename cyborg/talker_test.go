@@ -0,0 +1,50 @@
+package cyborg
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCutStatPeriod(t *testing.T) {
+	now := time.Date(2024, 5, 15, 13, 30, 0, 0, time.UTC) // Wednesday
+	for _, c := range []struct {
+		rq     string
+		rest   string
+		window int64
+		period string
+	}{
+		{"near sol today", "near sol", 13*3600 + 30*60 + 1, "today"},
+		{"near sol this  week", "near sol", 2*24*3600 + 13*3600 + 30*60 + 1, "this week"},
+		{"in the bubble last 3 hours", "in the bubble", 3 * 3600, "the last 3 hours"},
+		{"at colonia in the last day", "at colonia", 24 * 3600, "the last day"},
+		{"near lave past 2 weeks", "near lave", 2 * 7 * 24 * 3600, "the last 2 weeks"},
+		{"near lave last 0 days", "near lave", 24 * 3600, "the last day"},
+		{"near lave last 1000 weeks", "near lave", 52 * 7 * 24 * 3600, "the last 52 weeks"},
+		{"near lave last 99999999999999999999 hours", "near lave", 365 * 24 * 3600, "the last 8760 hours"},
+		{"near lave last -3 days", "near lave last -3 days", 0, ""},
+		{"near lave last fortnight", "near lave last fortnight", 0, ""},
+		{"near lave yesterday", "near lave yesterday", 0, ""},
+		{"near today's star", "near today's star", 0, ""},
+	} {
+		rest, window, period := cutStatPeriod(c.rq, now)
+		if rest != c.rest || window != c.window || period != c.period {
+			t.Errorf("%q: expected %q %d %q, got %q %d %q", c.rq, c.rest, c.window, c.period, rest, window, period)
+		}
+	}
+}
+
+func TestLastStatPeriod(t *testing.T) {
+	for window, expected := range map[int64]string{
+		3600:           "the last hour",
+		36 * 3600:      "the last 36 hours",
+		24 * 3600:      "the last day",
+		3 * 24 * 3600:  "the last 3 days",
+		7 * 24 * 3600:  "the last week",
+		14 * 24 * 3600: "the last 2 weeks",
+		30 * 60:        "the last 30 minutes",
+	} {
+		if period := lastStatPeriod(window); period != expected {
+			t.Errorf("%d: expected %q, got %q", window, expected, period)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package edGalaxy
 import (
 	"errors"
 	"log"
+	"strings"
 	"sync"
+	"time"
 )
 
 type BriefSystemInfo struct {
@@ -23,17 +25,104 @@ type StarInfo struct {
 	IsScoopable bool
 }
 
+/*
+	The giants and supergiants of the scoopable classes, as the journal names them
+*/
+var scoopableGiants = map[string]bool{
+	"A_BLUEWHITESUPERGIANT": true,
+	"B_BLUEWHITESUPERGIANT": true,
+	"F_WHITESUPERGIANT":     true,
+	"G_WHITESUPERGIANT":     true,
+	"K_ORANGEGIANT":         true,
+	"M_REDGIANT":            true,
+	"M_REDSUPERGIANT":       true,
+}
+
+/*
+	Main sequence K, G, B, F, O, A, M stars and their giants can be used for fuel scooping.
+	The class may have the subclass digit: "K", "K5". The classes merely starting with
+	the letters, like MS or AeBe, are not scoopable.
+*/
+func IsScoopableStarClass(spectralClass string) bool {
+	c := strings.ToUpper(strings.TrimSpace(spectralClass))
+	if len(c) == 0 {
+		return false
+	}
+	if scoopableGiants[c] {
+		return true
+	}
+	if !strings.ContainsAny(c[:1], "KGBFOAM") {
+		return false
+	}
+	for _, r := range c[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *StarInfo) IsNeutronStar() bool {
+	return s != nil && strings.Contains(strings.ToLower(s.Type), "neutron")
+}
+
 type ShortFactionState struct {
 	Name       string
 	State      string
 	Allegiance string
 }
 
+type FactionPresence struct {
+	SystemName    string
+	Influence     float64 // the fraction of 1, as in the influence history
+	State         string
+	IsControlling bool
+}
+
+type FactionInfo struct {
+	Name            string
+	Government      string
+	Allegiance      string
+	State           string
+	HomeSystem      string
+	IsPlayerFaction bool
+	Updated         int64
+	Presences       []*FactionPresence // the highest influence first
+}
+
 type InterestingSystem4State struct {
-	Name       string
-	Population int64
-	Coords     *Point3D
-	Factions   []*ShortFactionState
+	Name           string
+	Population     int64
+	Coords         *Point3D
+	Factions       []*ShortFactionState
+	LastEDDNUpdate int64 // unix time, 0 if never seen via EDDN
+}
+
+type LiveFactionState struct {
+	Name             string
+	Allegiance       string
+	Government       string
+	State            string
+	Influence        float64
+	PendingStates    []string
+	RecoveringStates []string
+}
+
+/*
+	System state as reported by the commanders via EDDN
+*/
+type LiveSystemState struct {
+	Name         string
+	Coords       *Point3D
+	Population   int64
+	Allegiance   string
+	Government   string
+	Security     string
+	Economy      string
+	Faction      string
+	FactionState string
+	Factions     []*LiveFactionState
+	Timestamp    time.Time
 }
 
 type SystemSummary struct {
@@ -44,6 +133,8 @@ type SystemSummary struct {
 	Coords      *Point3D
 	BriefInfo   *BriefSystemInfo
 	PrimaryStar *StarInfo
+	// unix time, 0 if never seen via EDDN
+	LastEDDNUpdate int64
 }
 
 type DockableStationShortInfo struct {
@@ -79,16 +170,220 @@ type HumanWorldStat struct {
 	Population    int64
 }
 
+type RouteWaypoint struct {
+	Name         string
+	Coords       *Point3D
+	Distance     float64 // from the previous waypoint
+	PrimaryStar  *StarInfo
+	Supercharged bool // the jump here was boosted by a neutron star
+}
+
+type RouteOptions struct {
+	ScoopableOnly bool
+	UseNeutrons   bool
+}
+
+type Route struct {
+	Waypoints     []*RouteWaypoint
+	Jumps         int
+	TotalDistance float64
+}
+
+type CommoditySearch struct {
+	Commodity        string
+	Origin           string
+	MinSupply        int
+	Tonnage          int // to sell
+	MinPad           string // S, M or L
+	AllowPlanetary   bool
+	MaxLocalDistance float64 // L.S., 0 - no limit
+	MaxDistance      float64
+	MaxMarketAge     int64 // seconds
+	Limit            int
+}
+
+type SuitablePoint struct {
+	Station        string
+	System         string
+	LandingPad     string
+	Planetary      bool
+	DistanceToStar float64 // arrival distance, L.S.
+	BuyPrice       int
+	Supply         int
+	SellPrice      int
+	Demand         int
+	Distance       float64 // from the origin system
+	MarketAge      int64   // seconds
+	PriceSource    string  // EDDB or EDDN
+}
+
+type TradeHop struct {
+	Commodity   string
+	FromStation string
+	FromSystem  string
+	ToStation   string
+	ToSystem    string
+	BuyPrice    int   // paid per ton at FromStation
+	SellPrice   int   // received per ton at ToStation
+	Units       int   // limited by the cargo capacity, supply and demand
+	Profit      int64 // for the whole load
+	Distance    float64
+}
+
+func (h *TradeHop) ProfitPerTon() int {
+	return h.SellPrice - h.BuyPrice
+}
+
+type TradeRoundTrip struct {
+	Outbound *TradeHop
+	Inbound  *TradeHop
+	Profit   int64
+}
+
+type TradeRoutes struct {
+	Hops       []*TradeHop
+	RoundTrips []*TradeRoundTrip
+}
+
 type ActivityStatItem struct {
   Timestamp int64
   NumJumps  int64
   NumDocks  int64
 }
 
+type StationActivity struct {
+	Name       string
+	SystemName string
+	Distance   float64
+	NumDocks   int64               // in the window
+	Docks      []*ActivityStatItem // per timeframe, the newest first, NumJumps is not used
+}
+
+/*
+	A system visited far above its usual, the rates are per hour
+*/
+type TrendingSystem struct {
+	Name         string
+	Distance     float64
+	Visits       int64 // in the window
+	Rate         float64
+	BaselineRate float64
+	Score        float64 // the standard deviations above the baseline
+}
+
+
+type InfluencePoint struct {
+	Timestamp        int64 // the day start, unix time
+	Influence        float64
+	State            string
+	PendingStates    []string
+	RecoveringStates []string
+}
+
+/*
+	Influence of one faction in one system
+*/
+type InfluenceSeries struct {
+	Name   string // the system for a faction history, the faction for a system history
+	Points []*InfluencePoint
+}
+
+type InfluenceHistory struct {
+	Name   string
+	Series []*InfluenceSeries
+}
+
+type InfluenceHistoryProvider interface {
+	GetFactionInfluenceHistory(faction string, days int) (*InfluenceHistory, error)
+	GetSystemInfluenceHistory(system string, days int) (*InfluenceHistory, error)
+}
+
+/*
+	A body scanned by the commanders
+*/
+type BodyInfo struct {
+	Name                string
+	BodyID              int
+	IsStar              bool
+	Type                string // the star type or the planet class
+	DistanceFromArrival float64
+	Landable            bool
+	TerraformState      string
+	Atmosphere          string
+	RingClasses         []string
+	WasDiscovered       bool
+	WasMapped           bool
+	Updated             int64 // unix time of the latest scan
+}
+
+type BodiesProvider interface {
+	GetSystemBodies(system string) ([]*BodyInfo, error)
+}
+
+/*
+	A fleet carrier where it was seen the last time
+*/
+type CarrierInfo struct {
+	Callsign string
+	MarketID int64
+	System   string
+	Coords   *Point3D
+	Services []string
+	LastSeen int64   // unix time
+	Distance float64 // from the search origin, GetCarriersNear only
+}
+
+type CarriersProvider interface {
+	GetCarrier(callsign string) (*CarrierInfo, error)
+	GetCarriersNear(coords *Point3D, maxDistance float64, limit int) ([]*CarrierInfo, error)
+}
+
+type UploaderStatItem struct {
+	Timestamp int64 // the hour start, unix time
+	Uploaders int64 // distinct
+	Messages  int64
+}
+
+type SoftwareShare struct {
+	Name      string
+	Uploaders int64
+	Messages  int64
+}
+
+/*
+	The EDDN uploaders seen in a region, the hours the newest first,
+	the client tools the most used first
+*/
+type UploaderStat struct {
+	Uploaders int64 // distinct in the window
+	Messages  int64
+	Hours     []*UploaderStatItem // every hour of the window, empty ones too
+	Software  []*SoftwareShare
+}
+
+/*
+	The seconds the stat covers, less than asked for when
+	the window is longer than the uploaders are kept
+*/
+func (s *UploaderStat) Window() int64 {
+	return int64(len(s.Hours)) * 3600
+}
+
+/*
+	The window is in seconds
+*/
+type UploaderStatProvider interface {
+	GetUploaderStat(coords *Point3D, maxDistance float64, window int64) (*UploaderStat, error)
+}
 
+/*
+	The windows are in seconds
+*/
 type VisitsStatProvider interface {
-	GetSystemVisitsStat(coords *Point3D, maxDistance float64, limit int)([]*SystemVisitsStat, int64, error)
-	GetActivityStat(coords *Point3D, maxDistance float64)([]*ActivityStatItem)
+	GetSystemVisitsStat(coords *Point3D, maxDistance float64, window int64, limit int)([]*SystemVisitsStat, int64, error)
+	GetActivityStat(coords *Point3D, maxDistance float64, window int64)([]*ActivityStatItem)
+	GetStationActivity(coords *Point3D, maxDistance float64, window int64, limit int)([]*StationActivity)
+	GetTrendingSystems(coords *Point3D, maxDistance float64, window int64, limit int)([]*TrendingSystem)
 }
 
 
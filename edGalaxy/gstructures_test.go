@@ -0,0 +1,29 @@
+package edGalaxy
+
+import (
+	"testing"
+)
+
+func TestIsScoopableStarClass(t *testing.T) {
+	for class, expected := range map[string]bool{
+		"K":                     true,
+		"g":                     true,
+		"M4":                    true,
+		" A0 ":                  true,
+		"K_OrangeGiant":         true,
+		"M_RedSuperGiant":       true,
+		"B_BlueWhiteSuperGiant": true,
+		"MS":                    false,
+		"AeBe":                  false,
+		"TTS":                   false,
+		"N":                     false,
+		"Y":                     false,
+		"A_BlueWhiteGiant":      false,
+		"K5a":                   false,
+		"":                      false,
+	} {
+		if got := IsScoopableStarClass(class); got != expected {
+			t.Errorf("%q: expected %v, got %v", class, expected, got)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package edGalaxy
+
+import (
+	"math"
+)
+
+/*
+	SpatialGrid is a uniform grid over the galaxy coordinates.
+	Each non-empty cell keeps the items placed inside it, so a radius
+	query only visits the cells intersecting the sphere bounding box.
+*/
+
+type gridCell struct {
+	x, y, z int64
+}
+
+type gridItem struct {
+	coords  Point3D
+	payload interface{}
+}
+
+type SpatialGrid struct {
+	cellSize float64
+	cells    map[gridCell][]gridItem
+	count    int
+}
+
+type SpatialGridVisitor func(payload interface{}, coords *Point3D, distance float64) bool
+
+func NewSpatialGrid(cellSize float64) *SpatialGrid {
+	if cellSize <= 0 {
+		cellSize = 50
+	}
+	return &SpatialGrid{
+		cellSize: cellSize,
+		cells:    make(map[gridCell][]gridItem),
+	}
+}
+
+func (g *SpatialGrid) cellOf(x, y, z float64) gridCell {
+	return gridCell{
+		x: int64(math.Floor(x / g.cellSize)),
+		y: int64(math.Floor(y / g.cellSize)),
+		z: int64(math.Floor(z / g.cellSize)),
+	}
+}
+
+func (g *SpatialGrid) Add(p *Point3D, payload interface{}) {
+	if p == nil {
+		return
+	}
+	c := g.cellOf(p.X, p.Y, p.Z)
+	g.cells[c] = append(g.cells[c], gridItem{coords: *p, payload: payload})
+	g.count++
+}
+
+func (g *SpatialGrid) Len() int {
+	return g.count
+}
+
+/*
+	FindInRange calls visit for every item not farther than radius from the center.
+	The order of the visits is not defined. The walk stops when visit returns false.
+*/
+func (g *SpatialGrid) FindInRange(center *Point3D, radius float64, visit SpatialGridVisitor) {
+	if center == nil || radius < 0 || math.IsNaN(radius) {
+		return
+	}
+	lo := g.cellOf(center.X-radius, center.Y-radius, center.Z-radius)
+	hi := g.cellOf(center.X+radius, center.Y+radius, center.Z+radius)
+
+	// A huge sphere would make us probe a lot of empty cells,
+	// it is cheaper to walk the populated ones then.
+	boxCells := float64(hi.x-lo.x+1) * float64(hi.y-lo.y+1) * float64(hi.z-lo.z+1)
+	if boxCells > float64(len(g.cells)) {
+		for c, items := range g.cells {
+			if c.x < lo.x || c.x > hi.x || c.y < lo.y || c.y > hi.y || c.z < lo.z || c.z > hi.z {
+				continue
+			}
+			if !visitItems(items, center, radius, visit) {
+				return
+			}
+		}
+		return
+	}
+
+	for x := lo.x; x <= hi.x; x++ {
+		for y := lo.y; y <= hi.y; y++ {
+			for z := lo.z; z <= hi.z; z++ {
+				items, exists := g.cells[gridCell{x, y, z}]
+				if !exists {
+					continue
+				}
+				if !visitItems(items, center, radius, visit) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func visitItems(items []gridItem, center *Point3D, radius float64, visit SpatialGridVisitor) bool {
+	for i := range items {
+		d := Distance(center, &items[i].coords)
+		if d > radius {
+			continue
+		}
+		if !visit(items[i].payload, &items[i].coords, d) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package edGalaxy
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func randomPoints(n int, spread float64, seed int64) []*Point3D {
+	rnd := rand.New(rand.NewSource(seed))
+	points := make([]*Point3D, n)
+	for i := range points {
+		points[i] = &Point3D{
+			X: (rnd.Float64() - 0.5) * spread,
+			Y: (rnd.Float64() - 0.5) * spread / 10,
+			Z: (rnd.Float64() - 0.5) * spread}
+	}
+	return points
+}
+
+func TestSpatialGridMatchesBruteForce(t *testing.T) {
+	points := randomPoints(5000, 2000, 42)
+	grid := NewSpatialGrid(50)
+	for i, p := range points {
+		grid.Add(p, i)
+	}
+	if grid.Len() != len(points) {
+		t.Fatalf("Len mismatch: %d vs %d", grid.Len(), len(points))
+	}
+
+	for _, radius := range []float64{0, 10, 49.9, 50, 120, 700, 100000} {
+		for _, center := range []*Point3D{Sol, points[7], &Point3D{X: 990, Y: -3, Z: -990}} {
+			expected := make([]int, 0)
+			for i, p := range points {
+				if center.Distance(p) <= radius {
+					expected = append(expected, i)
+				}
+			}
+			got := make([]int, 0)
+			grid.FindInRange(center, radius, func(payload interface{}, coords *Point3D, distance float64) bool {
+				got = append(got, payload.(int))
+				return true
+			})
+			sort.Ints(got)
+			if len(got) != len(expected) {
+				t.Fatalf("radius %.1f: got %d points, expected %d", radius, len(got), len(expected))
+			}
+			for i := range got {
+				if got[i] != expected[i] {
+					t.Fatalf("radius %.1f: point %d mismatch", radius, i)
+				}
+			}
+		}
+	}
+}
+
+func TestSpatialGridStopsWalk(t *testing.T) {
+	grid := NewSpatialGrid(10)
+	for i, p := range randomPoints(100, 20, 1) {
+		grid.Add(p, i)
+	}
+	visited := 0
+	grid.FindInRange(Sol, 1000, func(payload interface{}, coords *Point3D, distance float64) bool {
+		visited++
+		return visited < 3
+	})
+	if visited != 3 {
+		t.Fatalf("Expected the walk to stop after 3 visits, got %d", visited)
+	}
+}
+
+func BenchmarkSpatialGridFindInRange(b *testing.B) {
+	points := randomPoints(50000, 2000, 42)
+	grid := NewSpatialGrid(50)
+	for i, p := range points {
+		grid.Add(p, i)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		cnt := 0
+		grid.FindInRange(points[n%len(points)], 40, func(payload interface{}, coords *Point3D, distance float64) bool {
+			cnt++
+			return true
+		})
+	}
+}
+
+func BenchmarkBruteForceFindInRange(b *testing.B) {
+	points := randomPoints(50000, 2000, 42)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		cnt := 0
+		center := points[n%len(points)]
+		for _, p := range points {
+			if center.Distance(p) <= 40 {
+				cnt++
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package edGalaxy
+
+import (
+	"encoding/json"
+	"time"
+)
+
+/*
+	A resolution of the visit stat and how long it is kept
+*/
+type VisitStatTier struct {
+	Timeframe int64 // seconds
+	MaxMarks  int   // the retention in timeframes
+}
+
+func (t VisitStatTier) Span() int64 {
+	return t.Timeframe * int64(t.MaxMarks)
+}
+
+/*
+	Minutes for the last day, hours for a month, days for a year
+*/
+var DefaultVisitStatTiers = []VisitStatTier{
+	{Timeframe: 60, MaxMarks: 24 * 60},
+	{Timeframe: 3600, MaxMarks: 30 * 24},
+	{Timeframe: 24 * 3600, MaxMarks: 365}}
+
+/*
+	The finest tier keeping the window, the coarsest one if none does
+*/
+func PickVisitStatTier(tiers []VisitStatTier, window int64) VisitStatTier {
+	for _, t := range tiers {
+		if t.Span() >= window {
+			return t
+		}
+	}
+	return tiers[len(tiers)-1]
+}
+
+/*
+	The visits at several resolutions, the finest tier first. A visit is
+	counted in one tier only: the marks leaving a tier are rolled up to the
+	next one, the coarsest tier drops them.
+*/
+type TieredVisitStatCollector struct {
+	Tiers []*TimeVisitStatCollector `json:"tiers"`
+}
+
+func NewTieredVisitStatCollector(tiers []VisitStatTier) *TieredVisitStatCollector {
+	c := &TieredVisitStatCollector{Tiers: make([]*TimeVisitStatCollector, len(tiers))}
+	for i, t := range tiers {
+		c.Tiers[i] = NewTimeVisitStatCollector(t.MaxMarks, t.Timeframe)
+	}
+	return c
+}
+
+/*
+	The stat of the previous versions is one hourly collector, it is taken
+	as the only tier. ShipStatCollector retiers it on restore.
+*/
+func (c *TieredVisitStatCollector) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Tiers     []*TimeVisitStatCollector `json:"tiers"`
+		Timeframe int64                     `json:"timeframe"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+	if probe.Timeframe == 0 {
+		c.Tiers = probe.Tiers
+		return nil
+	}
+	var legacy TimeVisitStatCollector
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+	c.Tiers = []*TimeVisitStatCollector{&legacy}
+	return nil
+}
+
+func (c *TieredVisitStatCollector) HasTiers(tiers []VisitStatTier) bool {
+	if len(c.Tiers) != len(tiers) {
+		return false
+	}
+	for i, t := range tiers {
+		if c.Tiers[i].Timeframe != t.Timeframe || c.Tiers[i].MaxMarks != t.MaxMarks {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+	The same visits in the other tiers, at the resolution they were kept at or coarser
+*/
+func (c *TieredVisitStatCollector) Retier(tiers []VisitStatTier, now time.Time) *TieredVisitStatCollector {
+	rc := NewTieredVisitStatCollector(tiers)
+	for _, t := range c.Tiers {
+		for _, v := range t.Visits {
+			rc.addVisits(v.Timemark*t.Timeframe, v.VisitCount, t.Timeframe, now.Unix())
+		}
+	}
+	return rc
+}
+
+func (c *TieredVisitStatCollector) NoteVisit(timestamp time.Time) {
+	now := time.Now().Unix()
+	c.RollUp(now)
+	c.addVisits(timestamp.Unix(), 1, 0, now)
+}
+
+/*
+	Puts the visits to the finest tier not finer than minFrame still keeping them
+*/
+func (c *TieredVisitStatCollector) addVisits(ts int64, count int64, minFrame int64, now int64) {
+	for _, t := range c.Tiers {
+		if t.Timeframe < minFrame {
+			continue
+		}
+		if mark := ts / t.Timeframe; mark > now/t.Timeframe-int64(t.MaxMarks) {
+			t.addVisitsByTimemark(mark, count)
+			return
+		}
+	}
+}
+
+/*
+	Moves the marks leaving the tiers to the coarser ones, tells if any did
+*/
+func (c *TieredVisitStatCollector) RollUp(now int64) bool {
+	moved := false
+	for i, t := range c.Tiers {
+		oldest := now/t.Timeframe - int64(t.MaxMarks)
+		n := len(t.Visits)
+		for n > 0 && t.Visits[n-1].Timemark <= oldest {
+			n--
+		}
+		if n == len(t.Visits) {
+			continue
+		}
+		moved = true
+		expired := t.Visits[n:]
+		t.Visits = t.Visits[:n:n]
+		if i+1 == len(c.Tiers) {
+			continue
+		}
+		next := c.Tiers[i+1]
+		for _, v := range expired {
+			next.addVisitsByTimemark(v.Timemark*t.Timeframe/next.Timeframe, v.VisitCount)
+		}
+	}
+	return moved
+}
+
+/*
+	The visits since the unix time, the marks crossing it are counted in full
+*/
+func (c *TieredVisitStatCollector) CountSince(since int64) int64 {
+	var count int64 = 0
+	for _, t := range c.Tiers {
+		for _, v := range t.Visits {
+			if (v.Timemark+1)*t.Timeframe > since {
+				count += v.VisitCount
+			}
+		}
+	}
+	return count
+}
+
+/*
+	Passes the visits since the unix time in the marks of the frame,
+	the tiers coarser than the frame are skipped
+*/
+func (c *TieredVisitStatCollector) VisitsByFrame(frame int64, since int64, visit func(mark int64, count int64)) {
+	for _, t := range c.Tiers {
+		if t.Timeframe > frame {
+			continue
+		}
+		for _, v := range t.Visits {
+			if (v.Timemark+1)*t.Timeframe > since {
+				visit(v.Timemark*t.Timeframe/frame, v.VisitCount)
+			}
+		}
+	}
+}
+
+/*
+	Unlike noteVisitByTimemark it never drops the marks, RollUp does
+*/
+func (c *TimeVisitStatCollector) addVisitsByTimemark(timemark int64, count int64) {
+	for i, v := range c.Visits {
+		if v.Timemark == timemark {
+			v.VisitCount += count
+			return
+		}
+		if timemark > v.Timemark {
+			c.Visits = append(c.Visits, nil)
+			copy(c.Visits[i+1:], c.Visits[i:])
+			c.Visits[i] = &TimeVisitStat{timemark, count}
+			return
+		}
+	}
+	c.Visits = append(c.Visits, &TimeVisitStat{timemark, count})
+}
@@ -0,0 +1,96 @@
+package edGalaxy
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var testTiers = []VisitStatTier{{Timeframe: 60, MaxMarks: 60}, {Timeframe: 3600, MaxMarks: 24}, {Timeframe: 86400, MaxMarks: 7}}
+
+func TestTieredVisitStatRollUp(t *testing.T) {
+	now := int64(100 * 86400)
+	c := NewTieredVisitStatCollector(testTiers)
+	c.addVisits(now-30, 1, 0, now)       // the last minute
+	c.addVisits(now-30*60, 2, 0, now)    // half an hour ago
+	c.addVisits(now-5*3600, 3, 0, now)   // too old for the minutes
+	c.addVisits(now-3*86400, 4, 0, now)  // too old for the hours
+	c.addVisits(now-30*86400, 5, 0, now) // too old for every tier
+	if len(c.Tiers[0].Visits) != 2 || len(c.Tiers[1].Visits) != 1 || len(c.Tiers[2].Visits) != 1 {
+		t.Fatalf("Unexpected tiers: %d/%d/%d", len(c.Tiers[0].Visits), len(c.Tiers[1].Visits), len(c.Tiers[2].Visits))
+	}
+	if c.CountSince(0) != 10 || c.CountSince(now-3600) != 3 {
+		t.Fatalf("Unexpected counts: %d, %d", c.CountSince(0), c.CountSince(now-3600))
+	}
+
+	// in two hours the minutes go to the hours, in two days the hours go to the days
+	if !c.RollUp(now + 2*3600) {
+		t.Fatalf("Nothing rolled up")
+	}
+	if len(c.Tiers[0].Visits) != 0 || c.CountSince(0) != 10 {
+		t.Fatalf("The minutes are not rolled up: %d visits", c.CountSince(0))
+	}
+	c.RollUp(now + 2*86400)
+	if len(c.Tiers[1].Visits) != 0 || c.CountSince(0) != 10 {
+		t.Fatalf("The hours are not rolled up: %d visits", c.CountSince(0))
+	}
+	c.RollUp(now + 5*86400)
+	if c.CountSince(0) != 6 {
+		t.Fatalf("The days are not dropped: %d visits", c.CountSince(0))
+	}
+	if c.RollUp(now + 5*86400) {
+		t.Fatalf("Rolled up twice")
+	}
+}
+
+func TestTieredVisitStatFrames(t *testing.T) {
+	now := int64(100 * 86400)
+	c := NewTieredVisitStatCollector(testTiers)
+	c.addVisits(now-30, 1, 0, now)
+	c.addVisits(now-90, 2, 0, now)
+	c.addVisits(now-2*3600, 4, 0, now)
+	byHour := make(map[int64]int64)
+	c.VisitsByFrame(3600, now-3*3600, func(mark int64, count int64) {
+		byHour[mark] += count
+	})
+	if byHour[now/3600-1] != 3 || byHour[now/3600-2] != 4 {
+		t.Fatalf("Unexpected hours: %v", byHour)
+	}
+	if tier := PickVisitStatTier(testTiers, 3600); tier.Timeframe != 60 {
+		t.Fatalf("Expected the minutes for an hour, got %d", tier.Timeframe)
+	}
+	if tier := PickVisitStatTier(testTiers, 30*86400); tier.Timeframe != 86400 {
+		t.Fatalf("Expected the days for a month, got %d", tier.Timeframe)
+	}
+}
+
+func TestTieredVisitStatLegacyJSON(t *testing.T) {
+	hour := time.Now().Unix() / 3600
+	legacy := `{"max_marks":168,"timeframe":3600,"Visits":[{"time_mark":` + jsonInt(hour) + `,"visit_count":2},{"time_mark":` + jsonInt(hour-48) + `,"visit_count":3}]}`
+	var c TieredVisitStatCollector
+	if err := json.Unmarshal([]byte(legacy), &c); err != nil {
+		t.Fatalf("Legacy unmarshal failed: %v", err)
+	}
+	if c.HasTiers(testTiers) {
+		t.Fatalf("The legacy stat has the test tiers")
+	}
+	rc := c.Retier(testTiers, time.Now())
+	if !rc.HasTiers(testTiers) || rc.CountSince(0) != 5 {
+		t.Fatalf("Retier lost the visits: %d", rc.CountSince(0))
+	}
+	// the hours are never split to the minutes
+	if len(rc.Tiers[0].Visits) != 0 || len(rc.Tiers[1].Visits) != 1 || len(rc.Tiers[2].Visits) != 1 {
+		t.Fatalf("Unexpected tiers: %d/%d/%d", len(rc.Tiers[0].Visits), len(rc.Tiers[1].Visits), len(rc.Tiers[2].Visits))
+	}
+
+	data, _ := json.Marshal(rc)
+	var back TieredVisitStatCollector
+	if err := json.Unmarshal(data, &back); err != nil || !back.HasTiers(testTiers) || back.CountSince(0) != 5 {
+		t.Fatalf("Tiered unmarshal failed: %v", err)
+	}
+}
+
+func jsonInt(v int64) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
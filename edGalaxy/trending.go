@@ -0,0 +1,62 @@
+package edGalaxy
+
+import (
+	"math"
+)
+
+/*
+	The recent visits against the baseline frames right before them
+*/
+type VisitSurge struct {
+	Recent       int64   // the visits in the recent frames
+	RecentRate   float64 // per frame
+	BaselineRate float64 // per frame
+	Deviation    float64 // of the baseline visits per frame
+	Score        float64 // how many deviations the recent rate is above the baseline
+}
+
+/*
+	The deviation is floored by the Poisson one, sqrt of the baseline rate, and by 1,
+	so a quiet system does not trend on a couple of visits. The current frame is
+	counted as long as it lasted.
+*/
+func (c *TieredVisitStatCollector) Surge(frame int64, now int64, recentFrames int64, baselineFrames int64) VisitSurge {
+	var s VisitSurge
+	ctf := now / frame
+	recentSince := ctf - recentFrames + 1
+	baselineSince := recentSince - baselineFrames
+	baseline := make([]int64, baselineFrames)
+	c.VisitsByFrame(frame, baselineSince*frame, func(mark int64, count int64) {
+		if mark >= recentSince {
+			if mark <= ctf {
+				s.Recent += count
+			}
+		} else if mark >= baselineSince {
+			baseline[mark-baselineSince] += count
+		}
+	})
+
+	var sum float64 = 0
+	for _, n := range baseline {
+		sum += float64(n)
+	}
+	if baselineFrames > 0 {
+		s.BaselineRate = sum / float64(baselineFrames)
+	}
+	var sq float64 = 0
+	for _, n := range baseline {
+		d := float64(n) - s.BaselineRate
+		sq += d * d
+	}
+	if baselineFrames > 1 {
+		s.Deviation = math.Sqrt(sq / float64(baselineFrames-1))
+	}
+
+	elapsed := float64(now-recentSince*frame) / float64(frame)
+	if elapsed < 1 {
+		elapsed = 1
+	}
+	s.RecentRate = float64(s.Recent) / elapsed
+	s.Score = (s.RecentRate - s.BaselineRate) / math.Max(math.Max(s.Deviation, math.Sqrt(s.BaselineRate)), 1)
+	return s
+}
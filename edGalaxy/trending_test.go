@@ -0,0 +1,33 @@
+package edGalaxy
+
+import (
+	"testing"
+)
+
+func TestVisitSurge(t *testing.T) {
+	now := int64(100*86400 + 1800) // half an hour into the frame
+	steady := NewTieredVisitStatCollector(testTiers)
+	surging := NewTieredVisitStatCollector(testTiers)
+	for h := int64(1); h <= 24; h++ {
+		steady.addVisits(now-h*3600, 2, 0, now)
+		surging.addVisits(now-h*3600, 2, 0, now)
+	}
+	steady.addVisits(now-60, 2, 0, now)
+	surging.addVisits(now-60, 30, 0, now)
+
+	s := steady.Surge(3600, now, 1, 23)
+	if s.Recent != 2 || s.BaselineRate != 2 || s.Score > 1 {
+		t.Fatalf("The steady system surges: %+v", s)
+	}
+	s = surging.Surge(3600, now, 1, 23)
+	if s.Recent != 30 || s.RecentRate != 30 || s.Score < 10 {
+		t.Fatalf("The surge is not detected: %+v", s)
+	}
+
+	// a couple of visits to a quiet system are not a surge
+	quiet := NewTieredVisitStatCollector(testTiers)
+	quiet.addVisits(now-60, 2, 0, now)
+	if s = quiet.Surge(3600, now, 1, 23); s.Score > 2 {
+		t.Fatalf("The quiet system surges: %+v", s)
+	}
+}
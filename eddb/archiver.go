@@ -0,0 +1,240 @@
+package eddb
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	archivePrefix      = "eddn-"
+	archiveSuffix      = ".jsonl.gz"
+	archiveDayLayout   = "2006-01-02"
+	archiveQueueSize   = 1000
+	archiveFlushPeriod = time.Minute
+)
+
+/*
+	Schemas are the substrings of $schemaRef to keep, e.g. "journal/1",
+	every message is kept if empty. RetentionDays <= 0 keeps the files forever.
+*/
+type EDDNArchiveConfig struct {
+	Dir           string
+	RetentionDays int
+	Schemas       []string
+}
+
+/*
+	Every message the listener gets, called from the listen goroutine
+*/
+type EDDNMessageListener interface {
+	OnEDDNMessage(m *EDDNMessage)
+}
+
+/*
+	EDDNArchiver writes the received messages to the daily gzipped JSONL files,
+	eddn-YYYY-MM-DD.jsonl.gz in the UTC days. The writing is done in its own
+	goroutine, the messages it has no room for are dropped.
+*/
+type EDDNArchiver struct {
+	cfg      EDDNArchiveConfig
+	mtx      sync.RWMutex // guards closing the queue
+	closed   bool
+	messages chan *EDDNMessage
+	done     chan bool
+	dropped  int64
+
+	day string
+	f   *os.File
+	gz  *gzip.Writer
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func NewEDDNArchiver(cfg EDDNArchiveConfig) (*EDDNArchiver, error) {
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, err
+	}
+	a := &EDDNArchiver{
+		cfg:      cfg,
+		messages: make(chan *EDDNMessage, archiveQueueSize),
+		done:     make(chan bool)}
+	go a.writeMessages()
+	return a, nil
+}
+
+func ArchiveFileName(dir string, day time.Time) string {
+	return filepath.Join(dir, archivePrefix+day.UTC().Format(archiveDayLayout)+archiveSuffix)
+}
+
+/*
+	The archive files in the directory, the oldest first
+*/
+func ArchiveFiles(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		if !f.IsDir() && strings.HasPrefix(f.Name(), archivePrefix) && strings.HasSuffix(f.Name(), archiveSuffix) {
+			names = append(names, filepath.Join(dir, f.Name()))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (a *EDDNArchiver) accepts(m *EDDNMessage) bool {
+	if len(a.cfg.Schemas) == 0 {
+		return true
+	}
+	for _, s := range a.cfg.Schemas {
+		if strings.Contains(m.SchemaRef, s) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+	EDDNMessageListener implementation
+*/
+func (a *EDDNArchiver) OnEDDNMessage(m *EDDNMessage) {
+	if !a.accepts(m) {
+		return
+	}
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+	if a.closed {
+		return
+	}
+	select {
+	case a.messages <- m:
+	default:
+		atomic.AddInt64(&a.dropped, 1)
+	}
+}
+
+func (a *EDDNArchiver) Dropped() int64 {
+	return atomic.LoadInt64(&a.dropped)
+}
+
+/*
+	Writes the queued messages and closes the current file
+*/
+func (a *EDDNArchiver) Close() {
+	a.mtx.Lock()
+	a.closed = true
+	close(a.messages)
+	a.mtx.Unlock()
+	<-a.done
+}
+
+func (a *EDDNArchiver) writeMessages() {
+	ticker := time.NewTicker(archiveFlushPeriod)
+	defer ticker.Stop()
+	a.removeExpired(time.Now())
+	for {
+		select {
+		case m, more := <-a.messages:
+			if !more {
+				a.closeFile()
+				close(a.done)
+				return
+			}
+			a.write(m, time.Now())
+		case <-ticker.C:
+			a.flush()
+		}
+	}
+}
+
+func (a *EDDNArchiver) write(m *EDDNMessage, now time.Time) {
+	if day := now.UTC().Format(archiveDayLayout); day != a.day {
+		a.closeFile()
+		if !a.openFile(now) {
+			return
+		}
+		a.removeExpired(now)
+	}
+	if err := a.enc.Encode(m); err != nil {
+		log.Printf("Archive write to %s failed: %v\n", a.f.Name(), err)
+	}
+}
+
+/*
+	A restart appends a new gzip member to the day file, gzip readers take it as one stream
+*/
+func (a *EDDNArchiver) openFile(now time.Time) bool {
+	fn := ArchiveFileName(a.cfg.Dir, now)
+	f, err := os.OpenFile(fn, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Printf("Failed to open archive %s: %v\n", fn, err)
+		return false
+	}
+	a.day = now.UTC().Format(archiveDayLayout)
+	a.f = f
+	a.gz = gzip.NewWriter(f)
+	a.w = bufio.NewWriter(a.gz)
+	a.enc = json.NewEncoder(a.w)
+	log.Printf("Archiving EDDN to %s\n", fn)
+	return true
+}
+
+func (a *EDDNArchiver) flush() {
+	if a.f == nil {
+		return
+	}
+	err := a.w.Flush()
+	if err == nil {
+		err = a.gz.Flush()
+	}
+	if err != nil {
+		log.Printf("Archive flush to %s failed: %v\n", a.f.Name(), err)
+	}
+}
+
+func (a *EDDNArchiver) closeFile() {
+	if a.f == nil {
+		return
+	}
+	a.w.Flush()
+	a.gz.Close()
+	if err := a.f.Close(); err != nil {
+		log.Printf("Archive close of %s failed: %v\n", a.f.Name(), err)
+	}
+	a.f, a.gz, a.w, a.enc = nil, nil, nil, nil
+	a.day = ""
+}
+
+func (a *EDDNArchiver) removeExpired(now time.Time) {
+	if a.cfg.RetentionDays <= 0 {
+		return
+	}
+	oldest := ArchiveFileName(a.cfg.Dir, now.AddDate(0, 0, -a.cfg.RetentionDays+1))
+	files, err := ArchiveFiles(a.cfg.Dir)
+	if err != nil {
+		log.Printf("Failed to list archive %s: %v\n", a.cfg.Dir, err)
+		return
+	}
+	for _, fn := range files {
+		if fn >= oldest {
+			break
+		}
+		if err = os.Remove(fn); err != nil {
+			log.Printf("Failed to remove expired archive %s: %v\n", fn, err)
+		} else {
+			log.Printf("Expired archive %s removed\n", fn)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package eddb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestEDDNArchiver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eddnarchive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	expired := ArchiveFileName(dir, now.AddDate(0, 0, -5))
+	kept := ArchiveFileName(dir, now.AddDate(0, 0, -1))
+	for _, fn := range []string{expired, kept} {
+		if err = ioutil.WriteFile(fn, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a, err := NewEDDNArchiver(EDDNArchiveConfig{Dir: dir, RetentionDays: 3, Schemas: []string{"journal/1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range testEDDNMessages(now) {
+		a.OnEDDNMessage(&EDDNMessage{SchemaRef: "https://eddn.edcd.io/schemas/journal/1", Message: msg})
+	}
+	a.OnEDDNMessage(&EDDNMessage{SchemaRef: "https://eddn.edcd.io/schemas/commodity/3", Message: []byte(`{}`)})
+	a.Close()
+
+	files, err := ArchiveFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0] != kept || files[1] != ArchiveFileName(dir, now) {
+		t.Fatalf("Unexpected archive files: %v", files)
+	}
+
+	src, err := NewReplaySource(files[1], 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	n, err := c.Replay(src)
+	if err != nil || n != 3 {
+		t.Fatalf("Expected 3 archived messages, got %d: %v", n, err)
+	}
+}
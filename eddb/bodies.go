@@ -0,0 +1,124 @@
+package eddb
+
+import (
+	"container/list"
+	"errors"
+	"goed/edGalaxy"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const defaultMaxScannedSystems = 10000
+
+type systemBodies struct {
+	name   string
+	bodies map[int]*edGalaxy.BodyInfo // by BodyID
+}
+
+/*
+	BodyRegistry keeps the bodies reported by Scan events. Only the
+	maxSystems systems scanned most recently are kept, defaultMaxScannedSystems if not set.
+*/
+type BodyRegistry struct {
+	mtx        sync.RWMutex
+	maxSystems int
+	systems    map[string]*list.Element // upper system name -> *systemBodies
+	recent     *list.List               // the most recently scanned first
+}
+
+func NewBodyRegistry(maxSystems int) *BodyRegistry {
+	if maxSystems <= 0 {
+		maxSystems = defaultMaxScannedSystems
+	}
+	return &BodyRegistry{
+		maxSystems: maxSystems,
+		systems:    make(map[string]*list.Element),
+		recent:     list.New()}
+}
+
+func scan2galaxyBodyInfo(scan *ScanMessage) *edGalaxy.BodyInfo {
+	b := &edGalaxy.BodyInfo{
+		Name:                scan.BodyName,
+		BodyID:              scan.BodyID,
+		IsStar:              scan.IsStar(),
+		Type:                scan.PlanetClass,
+		DistanceFromArrival: scan.DistanceFromArrivalLS,
+		Landable:            scan.Landable,
+		TerraformState:      scan.TerraformState,
+		Atmosphere:          scan.Atmosphere,
+		RingClasses:         make([]string, len(scan.Rings)),
+		WasDiscovered:       scan.WasDiscovered,
+		WasMapped:           scan.WasMapped,
+		Updated:             scan.Timestamp.Unix()}
+	if b.IsStar {
+		b.Type = scan.StarType
+	}
+	for i, r := range scan.Rings {
+		// eRingClass_Metalic -> Metalic
+		rc := r.RingClass
+		if pos := strings.LastIndex(rc, "_"); pos >= 0 {
+			rc = rc[pos+1:]
+		}
+		b.RingClasses[i] = rc
+	}
+	return b
+}
+
+/*
+	ScanListener implementation
+*/
+func (r *BodyRegistry) OnScan(_ *EDDNMessage, scan *ScanMessage) {
+	if len(scan.StarSystem) == 0 || len(scan.BodyName) == 0 {
+		return
+	}
+	body := scan2galaxyBodyInfo(scan)
+	nm := strings.ToUpper(scan.StarSystem)
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	e, exists := r.systems[nm]
+	if exists {
+		r.recent.MoveToFront(e)
+	} else {
+		e = r.recent.PushFront(&systemBodies{name: scan.StarSystem, bodies: make(map[int]*edGalaxy.BodyInfo)})
+		r.systems[nm] = e
+		if r.recent.Len() > r.maxSystems {
+			oldest := r.recent.Back()
+			r.recent.Remove(oldest)
+			delete(r.systems, strings.ToUpper(oldest.Value.(*systemBodies).name))
+		}
+	}
+	sb := e.Value.(*systemBodies)
+	if known, exists := sb.bodies[body.BodyID]; exists && known.Updated > body.Updated {
+		return
+	}
+	sb.bodies[body.BodyID] = body
+}
+
+/*
+	The bodies by the distance from the arrival point, the stars first at the same distance
+*/
+func (r *BodyRegistry) GetSystemBodies(system string) ([]*edGalaxy.BodyInfo, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	e, exists := r.systems[strings.ToUpper(system)]
+	if !exists {
+		return nil, errors.New("No scans reported in the system")
+	}
+	sb := e.Value.(*systemBodies)
+	bodies := make([]*edGalaxy.BodyInfo, 0, len(sb.bodies))
+	for _, b := range sb.bodies {
+		bodies = append(bodies, b)
+	}
+	sort.Slice(bodies, func(i, j int) bool {
+		if bodies[i].DistanceFromArrival != bodies[j].DistanceFromArrival {
+			return bodies[i].DistanceFromArrival < bodies[j].DistanceFromArrival
+		}
+		if bodies[i].IsStar != bodies[j].IsStar {
+			return bodies[i].IsStar
+		}
+		return bodies[i].BodyID < bodies[j].BodyID
+	})
+	return bodies, nil
+}
@@ -0,0 +1,207 @@
+package eddb
+
+import (
+	"encoding/json"
+	"errors"
+	"goed/edGalaxy"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	stationTypeFleetCarrier = "FleetCarrier"
+)
+
+/*
+	CarrierRegistry keeps the fleet carriers where CarrierJump and
+	Docked events saw them the last time, by the market id.
+*/
+type CarrierRegistry struct {
+	mtx        sync.RWMutex
+	carriers   map[int64]*edGalaxy.CarrierInfo
+	byCallsign map[string]int64 // upper callsign -> market id
+}
+
+func NewCarrierRegistry() *CarrierRegistry {
+	return &CarrierRegistry{
+		carriers:   make(map[int64]*edGalaxy.CarrierInfo),
+		byCallsign: make(map[string]int64)}
+}
+
+func starPos2point(pos []float64) *edGalaxy.Point3D {
+	if len(pos) != 3 {
+		return nil
+	}
+	return &edGalaxy.Point3D{X: pos[0], Y: pos[1], Z: pos[2]}
+}
+
+/*
+	The carriers are never modified once stored, the update replaces the record.
+	The late reports do not override the fresh ones.
+*/
+func (r *CarrierRegistry) note(c *edGalaxy.CarrierInfo) {
+	if c.MarketID == 0 || len(c.Callsign) == 0 {
+		return
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if known, exists := r.carriers[c.MarketID]; exists {
+		if known.LastSeen > c.LastSeen {
+			if len(known.Services) == 0 && len(c.Services) > 0 {
+				// the services rarely change, the late report is better than nothing
+				kc := *known
+				kc.Services = c.Services
+				r.carriers[c.MarketID] = &kc
+			}
+			return
+		}
+		if len(c.Services) == 0 {
+			c.Services = known.Services
+		}
+	}
+	r.carriers[c.MarketID] = c
+	r.byCallsign[strings.ToUpper(c.Callsign)] = c.MarketID
+}
+
+/*
+	CarrierJumpListener implementation
+*/
+func (r *CarrierRegistry) OnCarrierJump(_ *EDDNMessage, jump *CarrierJumpMessage) {
+	if jump.StationType != stationTypeFleetCarrier {
+		return
+	}
+	r.note(&edGalaxy.CarrierInfo{
+		Callsign: jump.StationName,
+		MarketID: jump.MarketID,
+		System:   jump.StarSystem,
+		Coords:   starPos2point(jump.StarPos),
+		Services: jump.StationServices,
+		LastSeen: jump.Timestamp.Unix()})
+}
+
+/*
+	DockedListener implementation, only the docks at the carriers are taken
+*/
+func (r *CarrierRegistry) OnDocked(_ *EDDNMessage, docked *DockedMessage) {
+	if docked.StationType != stationTypeFleetCarrier {
+		return
+	}
+	r.note(&edGalaxy.CarrierInfo{
+		Callsign: docked.StationName,
+		MarketID: int64(docked.MarketID),
+		System:   docked.StarSystem,
+		Coords:   starPos2point(docked.StarPos),
+		Services: docked.StationServices,
+		LastSeen: docked.Timestamp.Unix()})
+}
+
+func (r *CarrierRegistry) GetCarrier(callsign string) (*edGalaxy.CarrierInfo, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	id, exists := r.byCallsign[strings.ToUpper(strings.TrimSpace(callsign))]
+	if !exists {
+		return nil, errors.New("The carrier was not seen yet")
+	}
+	return r.carriers[id], nil
+}
+
+/*
+	The carriers within maxDistance, the nearest first, the recently seen first
+	at the same distance. limit <= 0 - no limit.
+*/
+func (r *CarrierRegistry) GetCarriersNear(coords *edGalaxy.Point3D, maxDistance float64, limit int) ([]*edGalaxy.CarrierInfo, error) {
+	if coords == nil {
+		return nil, errors.New("The origin is not known")
+	}
+	near := make([]*edGalaxy.CarrierInfo, 0)
+	r.mtx.RLock()
+	for _, c := range r.carriers {
+		if c.Coords == nil {
+			continue
+		}
+		if d := coords.Distance(c.Coords); d <= maxDistance {
+			nc := *c
+			nc.Distance = d
+			near = append(near, &nc)
+		}
+	}
+	r.mtx.RUnlock()
+	sort.Slice(near, func(i, j int) bool {
+		if near[i].Distance != near[j].Distance {
+			return near[i].Distance < near[j].Distance
+		}
+		return near[i].LastSeen > near[j].LastSeen
+	})
+	if limit > 0 && len(near) > limit {
+		near = near[:limit]
+	}
+	return near, nil
+}
+
+func (r *CarrierRegistry) Len() int {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return len(r.carriers)
+}
+
+/*
+	Drops the carriers not seen for maxAge, they were decommissioned or parked off the lanes
+*/
+func (r *CarrierRegistry) Expire(maxAge time.Duration) {
+	oldest := time.Now().Add(-maxAge).Unix()
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for id, c := range r.carriers {
+		if c.LastSeen < oldest {
+			delete(r.carriers, id)
+			delete(r.byCallsign, strings.ToUpper(c.Callsign))
+		}
+	}
+}
+
+func (r *CarrierRegistry) Backup(fileName string) bool {
+	r.mtx.RLock()
+	err := writeJSONLines(fileName, func(enc *json.Encoder) error {
+		for _, c := range r.carriers {
+			if err := enc.Encode(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	r.mtx.RUnlock()
+	if err != nil {
+		log.Printf("Carriers backup to %s failed: %v\n", fileName, err)
+		return false
+	}
+	log.Printf("Carriers backup to %s succeeded\n", fileName)
+	return true
+}
+
+/*
+	The current carriers are kept if the file can not be read to the end
+*/
+func (r *CarrierRegistry) Restore(fileName string) bool {
+	restored := NewCarrierRegistry()
+	_, err := readJSONLines(fileName, func(line []byte) error {
+		var c edGalaxy.CarrierInfo
+		if err := json.Unmarshal(line, &c); err != nil {
+			log.Printf("Error unmarshaling carrier: %v\n", err)
+			return err
+		}
+		restored.note(&c)
+		return nil
+	})
+	if err != nil {
+		log.Printf("Carriers restore from %s failed: %v\n", fileName, err)
+		return false
+	}
+	r.mtx.Lock()
+	r.carriers, r.byCallsign = restored.carriers, restored.byCallsign
+	r.mtx.Unlock()
+	log.Printf("Carriers restore from %s succeeded: got %d carriers\n", fileName, r.Len())
+	return true
+}
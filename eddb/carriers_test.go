@@ -0,0 +1,70 @@
+package eddb
+
+import (
+	"goed/edGalaxy"
+	"testing"
+	"time"
+)
+
+func TestCarrierRegistry(t *testing.T) {
+	seen := time.Now().UTC().Add(-time.Hour)
+	at := func(d time.Duration) string {
+		return seen.Add(d).Format(time.RFC3339)
+	}
+	journal := "https://eddn.edcd.io/schemas/journal/1"
+	src := &eddnMessageSource{messages: []*EDDNMessage{
+		{SchemaRef: journal, Message: []byte(`{"event":"Docked","StarSystem":"Sol","StarPos":[0,0,0],"StationName":"X9Z-B0B","StationType":"FleetCarrier","MarketID":3700000001,` +
+			`"StationServices":["dock","refuel","commodities"],"timestamp":"` + at(0) + `"}`)},
+		{SchemaRef: journal, Message: []byte(`{"event":"CarrierJump","StarSystem":"Alpha Centauri","StarPos":[3.03125,-0.09375,3.15625],"StationName":"X9Z-B0B","StationType":"FleetCarrier",` +
+			`"MarketID":3700000001,"Docked":true,"timestamp":"` + at(time.Minute) + `"}`)},
+		{SchemaRef: journal, Message: []byte(`{"event":"Docked","StarSystem":"Lave","StarPos":[75.75,48.75,70.75],"StationName":"K7Q-12Z","StationType":"FleetCarrier","MarketID":3700000002,` +
+			`"timestamp":"` + at(0) + `"}`)},
+		// not a carrier
+		{SchemaRef: journal, Message: []byte(`{"event":"Docked","StarSystem":"Sol","StarPos":[0,0,0],"StationName":"Abraham Lincoln","StationType":"Orbis","MarketID":128016640,` +
+			`"timestamp":"` + at(0) + `"}`)},
+		// late report of the old position
+		{SchemaRef: journal, Message: []byte(`{"event":"Docked","StarSystem":"Sol","StarPos":[0,0,0],"StationName":"X9Z-B0B","StationType":"FleetCarrier","MarketID":3700000001,` +
+			`"timestamp":"` + at(-time.Minute) + `"}`)},
+	}}
+
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	carriers := NewCarrierRegistry()
+	c.AddCarrierJumpListener(carriers)
+	c.AddDockedListener(carriers)
+	c.Replay(src)
+
+	if carriers.Len() != 2 {
+		t.Fatalf("Expected 2 carriers, got %d", carriers.Len())
+	}
+	fc, err := carriers.GetCarrier("x9z-b0b")
+	if err != nil {
+		t.Fatalf("GetCarrier failed: %v", err)
+	}
+	if fc.System != "Alpha Centauri" || fc.LastSeen != seen.Add(time.Minute).Unix() || len(fc.Services) != 3 {
+		t.Fatalf("Unexpected carrier: %+v", fc)
+	}
+
+	near, err := carriers.GetCarriersNear(&edGalaxy.Point3D{}, 10, 0)
+	if err != nil || len(near) != 1 || near[0].Callsign != "X9Z-B0B" || near[0].Distance < 4 || near[0].Distance > 5 {
+		t.Fatalf("Unexpected carriers near Sol: %+v", near)
+	}
+	near, _ = carriers.GetCarriersNear(&edGalaxy.Point3D{}, 1000, 0)
+	if len(near) != 2 || near[1].Callsign != "K7Q-12Z" {
+		t.Fatalf("Unexpected carriers in the bubble: %+v", near)
+	}
+
+	restored := NewCarrierRegistry()
+	testRoundTrip(t, carriers.Backup, restored.Restore)
+	if restored.Len() != 2 {
+		t.Fatalf("Expected 2 restored carriers, got %d", restored.Len())
+	}
+	restored.Expire(90 * time.Minute)
+	if restored.Len() != 2 {
+		t.Fatalf("Nothing is expected to expire")
+	}
+	restored.Expire(time.Minute)
+	if restored.Len() != 0 {
+		t.Fatalf("Everything is expected to expire")
+	}
+}
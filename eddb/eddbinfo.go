@@ -4,19 +4,52 @@ import (
 	"errors"
 	"github.com/lithammer/fuzzysearch/fuzzy"
 	"goed/edGalaxy"
+	"goed/metrics"
 	"log"
-	"math"
 	"sort"
 	"strings"
 	"time"
 )
 
+const (
+	systemsGridCellSize = 50
+)
+
+var (
+	eddbLoadSeconds = metrics.NewHistogramVec("eddb_load_duration_seconds",
+		"The EDDB dumps load time by the kind of load", "kind", []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200})
+	eddbLoadFailures = metrics.NewCounterVec("eddb_load_failures_total",
+		"The EDDB dumps loads failed by the kind of load", "kind")
+)
+
+/*
+	Deferred with the start time, the failed loads are counted apart
+*/
+func noteEDDBLoad(kind string, start time.Time, err *error) {
+	if *err != nil {
+		eddbLoadFailures.With(kind).Inc()
+		return
+	}
+	eddbLoadSeconds.With(kind).ObserveSince(start)
+}
+
 type EDDBInfo struct {
-	commodities   *map[int]*CommodityRecordV5
-	systems       *map[int]*SystemRecordV5
-	stations      *map[int]*StationRecordV5
-	systemsByName *map[string]*SystemRecordV5
-	factions      *map[int]*FactionRecordV5
+	commodities    *map[int]*CommodityRecordV5
+	systems        *map[int]*SystemRecordV5
+	stations       *map[int]*StationRecordV5
+	systemsByName  *map[string]*SystemRecordV5
+	systemStations map[int][]*StationRecordV5
+	factions       *map[int]*FactionRecordV5
+	factionsByName map[string]*FactionRecordV5
+	presences      map[int][]*SystemRecordV5 // faction id -> the systems it is present in
+	primaryStars   *map[int]*BodyRecordV5
+	markets        map[int]*stationMarket        // the dump ones, by station id
+	commoditySyms  map[string]*CommodityRecordV5 // by the normalized name
+	live           *LiveSystemStates
+	liveMarkets    *LiveMarkets
+	marketStations map[int64]*StationRecordV5 // by the market id
+	systemsGrid    *edGalaxy.SpatialGrid
+	humanWorldStat *edGalaxy.HumanWorldStat
 }
 
 type SuitablePoint struct {
@@ -24,9 +57,21 @@ type SuitablePoint struct {
 	system   *SystemRecordV5
 	listing  *ListingRecordV5
 	distance float64
+	source   string // PriceSourceEDDB or PriceSourceEDDN
+	updated  int64  // the prices time, unix seconds
+}
+
+/*
+	A listing with where and when it comes from
+*/
+type marketListing struct {
+	listing *ListingRecordV5
+	source  string
+	updated int64
 }
 
-func BuildEDDBInfo(dataCache *DataCacheConfig) (*EDDBInfo, error) {
+func BuildEDDBInfo(dataCache *DataCacheConfig) (info *EDDBInfo, err error) {
+	defer noteEDDBLoad("build", time.Now(), &err)
 	log.Println("Reading eddb galaxy...")
 	commodities, err := ReadCommoditiesFile(dataCache.Commodities.LocalFile)
 	if err != nil {
@@ -62,30 +107,287 @@ func BuildEDDBInfo(dataCache *DataCacheConfig) (*EDDBInfo, error) {
 	}else{
 		log.Println("Commodities processing is not enabled in the configuration file.")
 	}
+	primaryStars, err := readPrimaryStars(dataCache)
+	if err != nil {
+		log.Printf("Failed to load bodies: %v", err)
+		return nil, err
+	}
+	return newEDDBInfo(commodities, systems, stations, factions, primaryStars), nil
+}
+
+/*
+	Refresh builds a new EDDBInfo re-reading only the updated files.
+	The unchanged record maps are shared with the current info, so the current
+	info stays consistent and can be used until the new one is published.
+	Commodities get new Selling/Buying maps if the listings are to be bound again.
+*/
+func (i *EDDBInfo) Refresh(dataCache *DataCacheConfig, updated []*CachedData) (info *EDDBInfo, err error) {
+	defer noteEDDBLoad("refresh", time.Now(), &err)
+	changed := func(d *CachedData) bool {
+		for _, u := range updated {
+			if u.LocalFile == d.LocalFile {
+				return true
+			}
+		}
+		return false
+	}
+	commodities, systems, stations, factions, primaryStars := i.commodities, i.systems, i.stations, i.factions, i.primaryStars
+
+	commoditiesChanged := changed(&dataCache.Commodities)
+	if commoditiesChanged {
+		log.Println("Reloading commodities...")
+		if commodities, err = ReadCommoditiesFile(dataCache.Commodities.LocalFile); err != nil {
+			log.Printf("Failed to load commodities: %v", err)
+			return nil, err
+		}
+	}
+	if changed(&dataCache.Systems) {
+		log.Println("Reloading systems...")
+		if systems, err = ReadSystemsFile(dataCache.Systems.LocalFile); err != nil {
+			log.Printf("Failed to load systems: %v", err)
+			return nil, err
+		}
+		log.Printf("Got %d systems\n", len(*systems))
+	}
+	stationsChanged := changed(&dataCache.Stations)
+	if stationsChanged {
+		log.Println("Reloading stations...")
+		if stations, err = ReadStationsFile(dataCache.Stations.LocalFile); err != nil {
+			log.Printf("Failed to load stations: %v", err)
+			return nil, err
+		}
+		log.Printf("Got %d stations\n", len(*stations))
+	}
+	if changed(&dataCache.Factions) {
+		log.Println("Reloading factions...")
+		if factions, err = ReadFactionsFile(dataCache.Factions.LocalFile); err != nil {
+			log.Printf("Failed to load factions: %v", err)
+			return nil, err
+		}
+		log.Printf("Got %d factions\n", len(*factions))
+	}
+	if changed(&dataCache.Bodies) {
+		log.Println("Reloading bodies...")
+		if primaryStars, err = readPrimaryStars(dataCache); err != nil {
+			log.Printf("Failed to load bodies: %v", err)
+			return nil, err
+		}
+	}
+
+	// the listings point to the stations and fill the commodities
+	if dataCache.ProcessListings && (commoditiesChanged || stationsChanged || changed(&dataCache.Listings)) {
+		if !commoditiesChanged {
+			commodities = cloneCommodities(commodities)
+		}
+		log.Println("Binding commodities...")
+		if err = BindStations(dataCache.Listings.LocalFile, commodities, stations); err != nil {
+			log.Printf("Unexpected error binding stations: %v\n", err)
+			return nil, err
+		}
+	}
+	info = newEDDBInfo(commodities, systems, stations, factions, primaryStars)
+	info.live = i.live
+	info.liveMarkets = i.liveMarkets
+	return info, nil
+}
+
+/*
+	The live states are consulted on top of the dump, nil switches the overlay off.
+	Refresh passes the overlay to the new info.
+*/
+func (i *EDDBInfo) SetLiveStates(live *LiveSystemStates) {
+	i.live = live
+}
+
+/*
+	The live markets are preferred over the dump listings when they are newer,
+	nil switches the overlay off. Refresh passes the overlay to the new info.
+*/
+func (i *EDDBInfo) SetLiveMarkets(markets *LiveMarkets) {
+	i.liveMarkets = markets
+}
+
+/*
+	The live market if it is newer than the station market in the dump
+*/
+func (i *EDDBInfo) getLiveMarket(st *StationRecordV5) *LiveMarket {
+	if i.liveMarkets == nil || st.EdMarketId == 0 {
+		return nil
+	}
+	m, exists := i.liveMarkets.Get(st.EdMarketId)
+	if !exists || m.Updated <= st.MarketUpdated {
+		return nil
+	}
+	return m
+}
+
+/*
+	The listings of the commodity the stations sell (or buy) merged
+	from the dump and the live markets, the fresher one wins per station.
+*/
+func (i *EDDBInfo) commodityListings(c *CommodityRecordV5, selling bool) []*marketListing {
+	listings := c.Buying
+	if selling {
+		listings = c.Selling
+	}
+	merged := make([]*marketListing, 0, len(listings))
+	for _, l := range listings {
+		if l.Station != nil && i.getLiveMarket(l.Station) != nil {
+			continue // taken from the live market below
+		}
+		var updated int64
+		if l.Station != nil {
+			updated = l.Station.MarketUpdated
+		}
+		merged = append(merged, &marketListing{l, PriceSourceEDDB, updated})
+	}
+	if i.liveMarkets == nil {
+		return merged
+	}
+	i.liveMarkets.forEach(func(m *LiveMarket) {
+		st, known := i.marketStations[m.MarketID]
+		if !known || m.Updated <= st.MarketUpdated {
+			return
+		}
+		p, listed := m.Price(c.Name)
+		if !listed || (selling && p.Stock <= 0) || (!selling && p.SellPrice <= 0) {
+			return
+		}
+		merged = append(merged, &marketListing{
+			listing: liveListing(st, c, m, p),
+			source:  PriceSourceEDDN,
+			updated: m.Updated})
+	})
+	return merged
+}
+
+/*
+	The live price in the shape of the dump listing
+*/
+func liveListing(st *StationRecordV5, c *CommodityRecordV5, m *LiveMarket, p *CommodityPrice) *ListingRecordV5 {
+	return &ListingRecordV5{
+		Station:        st,
+		Commodity:      c,
+		Supply:         p.Stock,
+		Supply_bracket: p.StockBracket,
+		Buy_price:      p.BuyPrice,
+		Sell_price:     p.SellPrice,
+		Demand:         p.Demand,
+		Demand_bracket: p.DemandBracket,
+		Collected_at:   m.Updated}
+}
+
+/*
+	The live state if it is newer than the dump record
+*/
+func (i *EDDBInfo) getLiveState(s *SystemRecordV5) *edGalaxy.LiveSystemState {
+	if i.live == nil {
+		return nil
+	}
+	ls, exists := i.live.Get(s.Name)
+	if !exists || ls.Timestamp.Unix() <= s.Updated {
+		return nil
+	}
+	return ls
+}
+
+/*
+	Copies the commodities without the listings
+*/
+func cloneCommodities(commodities *map[int]*CommodityRecordV5) *map[int]*CommodityRecordV5 {
+	m := make(map[int]*CommodityRecordV5, len(*commodities))
+	for id, c := range *commodities {
+		cc := *c
+		cc.Selling = nil
+		cc.Buying = nil
+		m[id] = &cc
+	}
+	return &m
+}
+
+func readPrimaryStars(dataCache *DataCacheConfig) (*map[int]*BodyRecordV5, error) {
+	if len(dataCache.Bodies.LocalFile) == 0 {
+		log.Println("Bodies file is not set in the configuration file, star types are unknown.")
+		m := make(map[int]*BodyRecordV5)
+		return &m, nil
+	}
+	primaryStars, err := ReadMainStarsFile(dataCache.Bodies.LocalFile)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Got %d primary stars\n", len(*primaryStars))
+	return primaryStars, nil
+}
+
+func newEDDBInfo(commodities *map[int]*CommodityRecordV5, systems *map[int]*SystemRecordV5, stations *map[int]*StationRecordV5, factions *map[int]*FactionRecordV5, primaryStars *map[int]*BodyRecordV5) *EDDBInfo {
 	log.Println("Mapping")
 	systemsByName := make(map[string]*SystemRecordV5)
 	for _, sys := range *systems {
 		systemsByName[strings.ToUpper(sys.Name)] = sys
 	}
 
+	// the records are shared between the reloads, so the links are kept aside
+	systemStations := make(map[int][]*StationRecordV5)
+	marketStations := make(map[int64]*StationRecordV5)
 	for _, station := range *stations {
-		system, exists := (*systems)[station.SystemId]
-		if !exists {
+		if _, exists := (*systems)[station.SystemId]; !exists {
 			log.Printf("Stations %d can not be mapped to system %d\n", station.Id, station.SystemId)
 			continue
 		}
-		if system.stations == nil {
-			m := make(map[int]*StationRecordV5)
-			system.stations = &m
+		systemStations[station.SystemId] = append(systemStations[station.SystemId], station)
+		if station.EdMarketId != 0 {
+			marketStations[station.EdMarketId] = station
 		}
-		(*(system.stations))[station.Id] = station
 	}
+	commoditySyms := make(map[string]*CommodityRecordV5, len(*commodities))
+	for _, c := range *commodities {
+		commoditySyms[normalizeCommodityName(c.Name)] = c
+	}
+	factionsByName := make(map[string]*FactionRecordV5)
+	for _, f := range *factions {
+		factionsByName[strings.ToUpper(f.Name)] = f
+	}
+	presences := make(map[int][]*SystemRecordV5)
+	for _, sys := range *systems {
+		for _, fp := range sys.FactionPresences {
+			known := presences[fp.FactionId]
+			if len(known) > 0 && known[len(known)-1] == sys {
+				continue // listed twice in the dump
+			}
+			presences[fp.FactionId] = append(known, sys)
+		}
+	}
+	log.Println("Indexing")
+	info := &EDDBInfo{commodities: commodities,
+		systems:        systems,
+		stations:       stations,
+		systemsByName:  &systemsByName,
+		systemStations: systemStations,
+		marketStations: marketStations,
+		commoditySyms:  commoditySyms,
+		factions:       factions,
+		factionsByName: factionsByName,
+		presences:      presences,
+		primaryStars:   primaryStars,
+		systemsGrid:    buildSystemsGrid(systems)}
+	info.markets = buildStationMarkets(commodities)
+	info.humanWorldStat = info.calcHumanWorldStat()
 	log.Println("Ready")
-	return &EDDBInfo{commodities: commodities,
-		systems:       systems,
-		stations:      stations,
-		systemsByName: &systemsByName,
-		factions:      factions}, nil
+	return info
+}
+
+func buildSystemsGrid(systems *map[int]*SystemRecordV5) *edGalaxy.SpatialGrid {
+	grid := edGalaxy.NewSpatialGrid(systemsGridCellSize)
+	for _, s := range *systems {
+		grid.Add(&edGalaxy.Point3D{X: s.X, Y: s.Y, Z: s.Z}, s)
+	}
+	return grid
+}
+
+func (i *EDDBInfo) systemsInRange(center *edGalaxy.Point3D, radius float64, visit func(s *SystemRecordV5, distance float64) bool) {
+	i.systemsGrid.FindInRange(center, radius, func(payload interface{}, _ *edGalaxy.Point3D, distance float64) bool {
+		return visit(payload.(*SystemRecordV5), distance)
+	})
 }
 
 func (i *EDDBInfo) GetSimilarSystemNames(sname string) []string {
@@ -103,6 +405,19 @@ func (i *EDDBInfo) GetSimilarSystemNames(sname string) []string {
 	return fuzzy.FindFold(sname, names)
 }
 
+func (i *EDDBInfo) GetSimilarCommodityNames(cname string) []string {
+	names := make([]string, 0, len(*i.commodities))
+	for _, c := range *i.commodities {
+		names = append(names, c.Name)
+	}
+	return fuzzy.FindFold(cname, names)
+}
+
+func (i *EDDBInfo) HasCommodity(cName string) bool {
+	_, ok := i.getCommodity(cName)
+	return ok
+}
+
 func (i *EDDBInfo) getCommodity(cName string) (*CommodityRecordV5, bool) {
 	cName = strings.ToLower(cName)
 	for _, c := range *i.commodities {
@@ -118,10 +433,44 @@ func (i *EDDBInfo) SystemSummaryByName(sName string) (*edGalaxy.SystemSummary, b
 	if !exists {
 		return nil, false
 	}
-	return eddb2galaxy(s), exists
+	summary := eddb2galaxy(s, i.getPrimaryStar(s.Id))
+	if ls := i.getLiveState(s); ls != nil {
+		applyLiveState(summary, ls)
+	}
+	return summary, exists
+}
+
+func applyLiveState(summary *edGalaxy.SystemSummary, ls *edGalaxy.LiveSystemState) {
+	bi := summary.BriefInfo
+	bi.Allegiance = ls.Allegiance
+	bi.Government = ls.Government
+	bi.Faction = ls.Faction
+	bi.FactionState = ls.FactionState
+	bi.Population = ls.Population
+	bi.Security = ls.Security
+	bi.Economy = ls.Economy
+	summary.LastEDDNUpdate = ls.Timestamp.Unix()
+}
+
+func (i *EDDBInfo) getPrimaryStar(systemId int) *edGalaxy.StarInfo {
+	b, exists := (*i.primaryStars)[systemId]
+	if !exists {
+		return nil
+	}
+	return eddbBody2galaxyStarInfo(b)
 }
 
-func eddb2galaxy(s *SystemRecordV5) *edGalaxy.SystemSummary {
+func eddbBody2galaxyStarInfo(b *BodyRecordV5) *edGalaxy.StarInfo {
+	if b == nil {
+		return nil
+	}
+	return &edGalaxy.StarInfo{
+		Name:        b.Name,
+		Type:        b.TypeName,
+		IsScoopable: edGalaxy.IsScoopableStarClass(b.SpectralClass)}
+}
+
+func eddb2galaxy(s *SystemRecordV5, primaryStar *edGalaxy.StarInfo) *edGalaxy.SystemSummary {
 	if s == nil {
 		return nil
 	}
@@ -138,7 +487,7 @@ func eddb2galaxy(s *SystemRecordV5) *edGalaxy.SystemSummary {
 			Reserve:      s.ReserveType,
 			Security:     s.Security,
 			Economy:      s.PrimaryEconomy},
-		PrimaryStar: nil,
+		PrimaryStar: primaryStar,
 	}
 }
 
@@ -171,11 +520,9 @@ func (i *EDDBInfo) GetDockableStations(sName string) ([]*edGalaxy.DockableStatio
 		return nil, false
 	}
 	sInfos := make([]*edGalaxy.DockableStationShortInfo, 0)
-	if s.stations != nil {
-		for _, st := range *(s.stations) {
-			if st.HasDocking {
-				sInfos = append(sInfos, eddbStation2galaxyDockableStationShortInfo(st))
-			}
+	for _, st := range i.systemStations[s.Id] {
+		if st.HasDocking {
+			sInfos = append(sInfos, eddbStation2galaxyDockableStationShortInfo(st))
 		}
 	}
 	return sInfos, true
@@ -186,17 +533,84 @@ func (i *EDDBInfo) FindCommodity(cName string, sName string, minSupply int, minP
 	if !ok {
 		return nil, errors.New("Unknown commodity")
 	}
+	spoints, err := i.findSuitablePoints(i.commodityListings(c, true), sName, minPad, allowPlanetary, maxLocalDist, maxDistance, maxUpdateAge,
+		func(l *ListingRecordV5) bool {
+			return l.Supply >= minSupply
+		})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(spoints, func(i, j int) bool {
+		return spoints[i].distance < spoints[j].distance
+	})
+	return spoints, nil
+}
 
+/*
+	FindSellPlaces is the mirror of FindCommodity: the stations are ranked
+	by the credits paid for the tonnage, the demand limits what can be sold.
+*/
+func (i *EDDBInfo) FindSellPlaces(cName string, sName string, tonnage int, minPad string, allowPlanetary bool, maxLocalDist float64, maxDistance float64, maxUpdateAge int64) ([]*SuitablePoint, error) {
+	if tonnage <= 0 {
+		return nil, errors.New("Tonnage must be positive")
+	}
+	c, ok := i.getCommodity(cName)
+	if !ok {
+		return nil, errors.New("Unknown commodity")
+	}
+	spoints, err := i.findSuitablePoints(i.commodityListings(c, false), sName, minPad, allowPlanetary, maxLocalDist, maxDistance, maxUpdateAge,
+		func(l *ListingRecordV5) bool {
+			return l.Demand > 0 && l.Sell_price > 0
+		})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(spoints, func(i, j int) bool {
+		ci, cj := spoints[i].sellCredits(tonnage), spoints[j].sellCredits(tonnage)
+		if ci != cj {
+			return ci > cj
+		}
+		if spoints[i].listing.Sell_price != spoints[j].listing.Sell_price {
+			return spoints[i].listing.Sell_price > spoints[j].listing.Sell_price
+		}
+		return spoints[i].distance < spoints[j].distance
+	})
+	return spoints, nil
+}
+
+func (p *SuitablePoint) sellCredits(tonnage int) int64 {
+	if p.listing.Demand < tonnage {
+		tonnage = p.listing.Demand
+	}
+	return int64(tonnage) * int64(p.listing.Sell_price)
+}
+
+func (i *EDDBInfo) findSuitablePoints(listings []*marketListing, sName string, minPad string, allowPlanetary bool, maxLocalDist float64, maxDistance float64, maxUpdateAge int64, accept func(l *ListingRecordV5) bool) ([]*SuitablePoint, error) {
 	originSystem, ok := i.GetSystemByName(sName)
 	if !ok {
 		return nil, errors.New("Unknown system")
 	}
-	minPad = strings.ToUpper(minPad)
+	pad := 1
+	if len(minPad) > 0 {
+		pad, ok = padSizes[strings.ToUpper(minPad)]
+		if !ok {
+			return nil, errors.New("Landing pad must be one of S, M or L")
+		}
+	}
 	nowSenonds := time.Now().Unix()
 
+	systemDistances := make(map[int]float64)
+	i.systemsInRange(originSystem.GetCoordinates(), maxDistance, func(s *SystemRecordV5, distance float64) bool {
+		if distance < maxDistance {
+			systemDistances[s.Id] = distance
+		}
+		return true
+	})
+
 	spoints := make([]*SuitablePoint, 0)
-	for _, l := range c.Selling {
-		if l.Supply < minSupply {
+	for _, ml := range listings {
+		l := ml.listing
+		if !accept(l) {
 			continue
 		}
 		st := l.Station
@@ -207,31 +621,50 @@ func (i *EDDBInfo) FindCommodity(cName string, sName string, minSupply int, minP
 		if !allowPlanetary && st.Planerary {
 			continue
 		}
-		if minPad == "L" && strings.ToUpper(st.MaxLandingPad) != minPad {
+		if !padFits(st, pad) {
+			continue
+		}
+		if maxLocalDist > 0 && st.DistanceToStar > maxLocalDist {
+			continue
+		}
+		if nowSenonds-ml.updated > maxUpdateAge {
 			continue
 		}
-		if nowSenonds-st.MarketUpdated > maxUpdateAge {
+		stardis, inRange := systemDistances[st.SystemId]
+		if !inRange {
 			continue
 		}
 		ss := (*i.systems)[st.SystemId]
 		if ss == nil {
 			log.Printf("Can't find system for station %d %s\n", st.Id, st.Name)
+			continue
 		}
-		if ss != nil {
-			dx := originSystem.X - ss.X
-			dy := originSystem.Y - ss.Y
-			dz := originSystem.Z - ss.Z
-			stardis := math.Sqrt(dx*dx + dy*dy + dz*dz)
-			if stardis < maxDistance {
-				spoints = append(spoints, &SuitablePoint{st, ss, l, stardis})
-			}
-		}
+		spoints = append(spoints, &SuitablePoint{st, ss, l, stardis, ml.source, ml.updated})
 	}
-	sort.Slice(spoints, func(i, j int) bool {
-		return spoints[i].distance < spoints[j].distance
-	})
 	return spoints, nil
 }
+
+func SuitablePoints2galaxy(points []*SuitablePoint) []*edGalaxy.SuitablePoint {
+	nowSeconds := time.Now().Unix()
+	gpoints := make([]*edGalaxy.SuitablePoint, len(points))
+	for n, p := range points {
+		gpoints[n] = &edGalaxy.SuitablePoint{
+			Station:        p.station.Name,
+			System:         p.system.Name,
+			LandingPad:     p.station.MaxLandingPad,
+			Planetary:      p.station.Planerary,
+			DistanceToStar: p.station.DistanceToStar,
+			BuyPrice:       p.listing.Buy_price,
+			Supply:         p.listing.Supply,
+			SellPrice:      p.listing.Sell_price,
+			Demand:         p.listing.Demand,
+			Distance:       p.distance,
+			MarketAge:      nowSeconds - p.updated,
+			PriceSource:    p.source}
+	}
+	return gpoints
+}
+
 func (s *SystemRecordV5) GetCoordinates() *edGalaxy.Point3D {
 	return &edGalaxy.Point3D{X: s.X, Y: s.Y, Z: s.Z}
 }
@@ -244,6 +677,63 @@ func (i *EDDBInfo) getShortFactionState(factionId int) *edGalaxy.ShortFactionSta
 	return &edGalaxy.ShortFactionState{Name: fInfo.Name, State: fInfo.State, Allegiance: fInfo.Allegiance}
 }
 
+func liveFactions2short(live []*edGalaxy.LiveFactionState) []*edGalaxy.ShortFactionState {
+	factions := make([]*edGalaxy.ShortFactionState, len(live))
+	for n, f := range live {
+		factions[n] = &edGalaxy.ShortFactionState{Name: f.Name, State: f.State, Allegiance: f.Allegiance}
+	}
+	return factions
+}
+
+func (i *EDDBInfo) GetSimilarFactionNames(fname string) []string {
+	names := make([]string, 0, len(i.factionsByName))
+	for _, f := range i.factionsByName {
+		names = append(names, f.Name)
+	}
+	return fuzzy.FindFold(fname, names)
+}
+
+/*
+	The faction profile as the dump has it with the systems it is present in
+*/
+func (i *EDDBInfo) GetFactionInfo(fName string) (*edGalaxy.FactionInfo, bool) {
+	f, exists := i.factionsByName[strings.ToUpper(fName)]
+	if !exists {
+		return nil, false
+	}
+	info := &edGalaxy.FactionInfo{
+		Name:            f.Name,
+		Government:      f.Government,
+		Allegiance:      f.Allegiance,
+		State:           f.State,
+		IsPlayerFaction: f.IsPlayerFaction,
+		Updated:         f.UpdatedAt,
+		Presences:       make([]*edGalaxy.FactionPresence, 0, len(i.presences[f.ID]))}
+	if home, exists := (*i.systems)[f.HomeSystemID]; exists {
+		info.HomeSystem = home.Name
+	}
+	for _, s := range i.presences[f.ID] {
+		for _, fp := range s.FactionPresences {
+			if fp.FactionId != f.ID {
+				continue
+			}
+			info.Presences = append(info.Presences, &edGalaxy.FactionPresence{
+				SystemName:    s.Name,
+				Influence:     fp.FactionInfluence / 100, // the dump has percents
+				State:         fp.FactionState,
+				IsControlling: s.ControllingMinofFactionId == f.ID})
+			break
+		}
+	}
+	sort.Slice(info.Presences, func(a, b int) bool {
+		if info.Presences[a].Influence != info.Presences[b].Influence {
+			return info.Presences[a].Influence > info.Presences[b].Influence
+		}
+		return info.Presences[a].SystemName < info.Presences[b].SystemName
+	})
+	return info, true
+}
+
 func (i *EDDBInfo) FindStates(states []string, place *edGalaxy.Point3D, minPop int64, maxDistance float64, maxEntries int) []*edGalaxy.InterestingSystem4State {
 
 	wantedStates := make(map[string]bool)
@@ -253,39 +743,54 @@ func (i *EDDBInfo) FindStates(states []string, place *edGalaxy.Point3D, minPop i
 	}
 
 	suitableSystems := make([]*edGalaxy.InterestingSystem4State, 0)
-	for _, s := range *i.systems {
-		if _, wanted := wantedStates[strings.ToUpper(s.State)]; !wanted {
-			continue
+	i.systemsInRange(place, maxDistance, func(s *SystemRecordV5, _ float64) bool {
+		state, population := s.State, s.Population
+		ls := i.getLiveState(s)
+		if ls != nil {
+			state, population = ls.FactionState, ls.Population
 		}
-		if s.Population < minPop {
-			continue
-		}
-		coords := s.GetCoordinates()
-		if place.Distance(coords) > maxDistance {
-			continue
+		if _, wanted := wantedStates[strings.ToUpper(state)]; !wanted {
+			return true
 		}
-		if s.FactionPresences == nil {
-			continue
+		if population < minPop {
+			return true
 		}
-		factions := make([]*edGalaxy.ShortFactionState, 0)
-		for _, fc := range s.FactionPresences {
-			si := i.getShortFactionState(fc.FactionId)
-			if si != nil {
-				factions = append(factions, si)
+		var factions []*edGalaxy.ShortFactionState
+		var lastEDDNUpdate int64 = 0
+		if ls != nil {
+			factions = liveFactions2short(ls.Factions)
+			lastEDDNUpdate = ls.Timestamp.Unix()
+		} else {
+			if s.FactionPresences == nil {
+				return true
+			}
+			factions = make([]*edGalaxy.ShortFactionState, 0)
+			for _, fc := range s.FactionPresences {
+				si := i.getShortFactionState(fc.FactionId)
+				if si != nil {
+					factions = append(factions, si)
+				}
 			}
 		}
 		if len(factions) > 0 {
 			suitableSystems = append(suitableSystems,
-				&edGalaxy.InterestingSystem4State{Name: s.Name, Population: s.Population, Coords: coords, Factions: factions})
+				&edGalaxy.InterestingSystem4State{Name: s.Name, Population: population, Coords: s.GetCoordinates(), Factions: factions, LastEDDNUpdate: lastEDDNUpdate})
 			if len(suitableSystems) >= maxEntries {
-				break
-			}			
+				return false
+			}
 		}
-	}
+		return true
+	})
 	return suitableSystems
 }
 
 func (i *EDDBInfo) GetHumanWorldStat() *edGalaxy.HumanWorldStat {
+	// the numbers only change on reload, so they are calculated once
+	ws := *i.humanWorldStat
+	return &ws
+}
+
+func (i *EDDBInfo) calcHumanWorldStat() *edGalaxy.HumanWorldStat {
 	var population int64 = 0
 	for _, system := range *i.systems {
 		population += system.Population
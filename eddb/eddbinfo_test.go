@@ -0,0 +1,268 @@
+package eddb
+
+import (
+	"fmt"
+	"goed/edGalaxy"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testStates = []string{"Boom", "War", "Election", "None", "Expansion"}
+
+func buildTestEDDBInfo(nSystems int) *EDDBInfo {
+	rnd := rand.New(rand.NewSource(7))
+	factions := make(map[int]*FactionRecordV5)
+	for i := 1; i <= 50; i++ {
+		factions[i] = &FactionRecordV5{ID: i, Name: fmt.Sprintf("Faction %d", i), State: testStates[i%len(testStates)]}
+	}
+	commodities := map[int]*CommodityRecordV5{
+		1: &CommodityRecordV5{Id: 1, Name: "Gold", Selling: make(map[int]*ListingRecordV5)},
+	}
+	systems := make(map[int]*SystemRecordV5)
+	stations := make(map[int]*StationRecordV5)
+	now := time.Now().Unix()
+	for i := 1; i <= nSystems; i++ {
+		s := &SystemRecordV5{
+			Id:         i,
+			Name:       fmt.Sprintf("System %d", i),
+			X:          (rnd.Float64() - 0.5) * 3000,
+			Y:          (rnd.Float64() - 0.5) * 300,
+			Z:          (rnd.Float64() - 0.5) * 3000,
+			Population: rnd.Int63n(1000000),
+			State:      testStates[rnd.Intn(len(testStates))],
+			FactionPresences: []MinorFactionPresenceRecordV5{
+				{FactionId: 1 + rnd.Intn(50)},
+				{FactionId: 1 + rnd.Intn(50)}},
+		}
+		systems[i] = s
+		if i%3 == 0 {
+			st := &StationRecordV5{Id: i, Name: fmt.Sprintf("Station %d", i), SystemId: i, MaxLandingPad: "L", MarketUpdated: now}
+			stations[i] = st
+			commodities[1].Selling[i] = &ListingRecordV5{Id: i, Station: st, Commodity: commodities[1], Supply: 100}
+		}
+	}
+	primaryStars := make(map[int]*BodyRecordV5)
+	return newEDDBInfo(&commodities, &systems, &stations, &factions, &primaryStars)
+}
+
+// the answers given by the straightforward scan of every system
+func bruteForceFindStates(i *EDDBInfo, states []string, place *edGalaxy.Point3D, minPop int64, maxDistance float64) []string {
+	wantedStates := make(map[string]bool)
+	for _, state := range states {
+		wantedStates[strings.ToUpper(state)] = true
+	}
+	names := make([]string, 0)
+	for _, s := range *i.systems {
+		if _, wanted := wantedStates[strings.ToUpper(s.State)]; !wanted {
+			continue
+		}
+		if s.Population < minPop {
+			continue
+		}
+		if place.Distance(s.GetCoordinates()) > maxDistance {
+			continue
+		}
+		names = append(names, s.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestFindStatesMatchesBruteForce(t *testing.T) {
+	info := buildTestEDDBInfo(20000)
+	for _, origin := range []string{"System 1", "System 777", "System 19999"} {
+		place, _ := info.GetSystemCoordsByName(origin)
+		for _, radius := range []float64{15, 60, 250} {
+			expected := bruteForceFindStates(info, []string{"war", "Election"}, place, 1000, radius)
+			res := info.FindStates([]string{"war", "Election"}, place, 1000, radius, len(*info.systems))
+			got := make([]string, len(res))
+			for i, r := range res {
+				got[i] = r.Name
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(expected, ",") {
+				t.Fatalf("%s inside %.0f: got %v, expected %v", origin, radius, got, expected)
+			}
+		}
+	}
+}
+
+func TestFindCommodityMatchesBruteForce(t *testing.T) {
+	info := buildTestEDDBInfo(20000)
+	origin, _ := info.GetSystemByName("System 300")
+	for _, radius := range []float64{30, 100, 400} {
+		expected := make([]int, 0)
+		for _, l := range (*info.commodities)[1].Selling {
+			ss := (*info.systems)[l.Station.SystemId]
+			if edGalaxy.Distance(origin.GetCoordinates(), ss.GetCoordinates()) < radius {
+				expected = append(expected, l.Station.Id)
+			}
+		}
+		sort.Ints(expected)
+		points, err := info.FindCommodity("gold", origin.Name, 1, "M", true, 1000, radius, 3600)
+		if err != nil {
+			t.Fatalf("FindCommodity failed: %v", err)
+		}
+		got := make([]int, len(points))
+		for i, p := range points {
+			got[i] = p.station.Id
+			if i > 0 && points[i-1].distance > p.distance {
+				t.Fatalf("Points are not sorted by distance")
+			}
+		}
+		sort.Ints(got)
+		if fmt.Sprint(got) != fmt.Sprint(expected) {
+			t.Fatalf("Inside %.0f: got %v, expected %v", radius, got, expected)
+		}
+	}
+}
+
+func BenchmarkFindStatesIndexed(b *testing.B) {
+	info := buildTestEDDBInfo(50000)
+	place, _ := info.GetSystemCoordsByName("System 1")
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		info.FindStates([]string{"war"}, place, 1000, 50, 1000)
+	}
+}
+
+func BenchmarkFindStatesBruteForce(b *testing.B) {
+	info := buildTestEDDBInfo(50000)
+	place, _ := info.GetSystemCoordsByName("System 1")
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		bruteForceFindStates(info, []string{"war"}, place, 1000, 50)
+	}
+}
+
+func writeTestFile(t *testing.T, dir string, name string, content string) string {
+	fn := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(fn, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", fn, err)
+	}
+	return fn
+}
+
+func TestRefreshRereadsOnlyUpdated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eddb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	dc := &DataCacheConfig{ProcessListings: true}
+	dc.Commodities.LocalFile = writeTestFile(t, dir, "commodities.json", `[{"id":1,"name":"Gold"}]`)
+	dc.Systems.LocalFile = writeTestFile(t, dir, "systems.jsonl", `{"id":1,"name":"Alpha"}`+"\n"+`{"id":2,"name":"Beta","x":10}`)
+	dc.Stations.LocalFile = writeTestFile(t, dir, "stations.jsonl", `{"id":1,"name":"Alpha Port","system_id":1,"has_docking":true}`)
+	dc.Factions.LocalFile = writeTestFile(t, dir, "factions.jsonl", `{"id":1,"name":"Old Guard"}`)
+	listingsHeader := "id,station_id,commodity_id,supply,supply_bracket,buy_price,sell_price,demand,demand_bracket,collected_at\n"
+	dc.Listings.LocalFile = writeTestFile(t, dir, "listings.csv", listingsHeader+"1,1,1,100,2,1000,900,0,0,1500000000\n")
+
+	info, err := BuildEDDBInfo(dc)
+	if err != nil {
+		t.Fatalf("BuildEDDBInfo failed: %v", err)
+	}
+
+	writeTestFile(t, dir, "factions.jsonl", `{"id":1,"name":"New Order"}`)
+	refreshed, err := info.Refresh(dc, []*CachedData{&dc.Factions})
+	if err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if refreshed.systems != info.systems || refreshed.commodities != info.commodities || refreshed.stations != info.stations {
+		t.Fatalf("Unchanged datasets must be shared")
+	}
+	if (*refreshed.factions)[1].Name != "New Order" || (*info.factions)[1].Name != "Old Guard" {
+		t.Fatalf("Factions are not refreshed properly")
+	}
+
+	writeTestFile(t, dir, "listings.csv", listingsHeader+"2,1,1,500,2,1100,900,0,0,1500000000\n")
+	relisted, err := refreshed.Refresh(dc, []*CachedData{&dc.Listings})
+	if err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if relisted.systems != info.systems || relisted.commodities == info.commodities {
+		t.Fatalf("Only the commodities must be copied on listings update")
+	}
+	if _, old := (*info.commodities)[1].Selling[1]; !old || len((*info.commodities)[1].Selling) != 1 {
+		t.Fatalf("The current info must not be touched")
+	}
+	if l, fresh := (*relisted.commodities)[1].Selling[2]; !fresh || l.Supply != 500 || len((*relisted.commodities)[1].Selling) != 1 {
+		t.Fatalf("The listings are not rebound")
+	}
+	if stations, _ := relisted.GetDockableStations("alpha"); len(stations) != 1 {
+		t.Fatalf("Stations are not linked to the systems")
+	}
+}
+
+func TestGetFactionInfo(t *testing.T) {
+	factions := map[int]*FactionRecordV5{
+		1: &FactionRecordV5{ID: 1, Name: "Sol Workers' Party", Government: "Democracy", HomeSystemID: 2, IsPlayerFaction: true},
+		2: &FactionRecordV5{ID: 2, Name: "Mother Gaia", Government: "Democracy"},
+	}
+	systems := map[int]*SystemRecordV5{
+		1: &SystemRecordV5{Id: 1, Name: "Sol", ControllingMinofFactionId: 2, FactionPresences: []MinorFactionPresenceRecordV5{
+			{FactionId: 1, FactionInfluence: 20, FactionState: "Boom"},
+			{FactionId: 2, FactionInfluence: 80}}},
+		2: &SystemRecordV5{Id: 2, Name: "Barnard's Star", ControllingMinofFactionId: 1, FactionPresences: []MinorFactionPresenceRecordV5{
+			{FactionId: 1, FactionInfluence: 60}}},
+	}
+	commodities := make(map[int]*CommodityRecordV5)
+	stations := make(map[int]*StationRecordV5)
+	primaryStars := make(map[int]*BodyRecordV5)
+	info := newEDDBInfo(&commodities, &systems, &stations, &factions, &primaryStars)
+
+	f, ok := info.GetFactionInfo("sol workers' party")
+	if !ok {
+		t.Fatalf("Faction not found")
+	}
+	if f.HomeSystem != "Barnard's Star" || !f.IsPlayerFaction || len(f.Presences) != 2 {
+		t.Fatalf("Unexpected faction info: %+v", f)
+	}
+	if p := f.Presences[0]; p.SystemName != "Barnard's Star" || !p.IsControlling || p.Influence != 0.6 {
+		t.Fatalf("Unexpected first presence: %+v", p)
+	}
+	if p := f.Presences[1]; p.SystemName != "Sol" || p.IsControlling || p.State != "Boom" || p.Influence != 0.2 {
+		t.Fatalf("Unexpected second presence: %+v", p)
+	}
+
+	if _, ok = info.GetFactionInfo("Sol Workers"); ok {
+		t.Fatalf("Partial names must not match")
+	}
+	if suggested := info.GetSimilarFactionNames("workers"); len(suggested) != 1 || suggested[0] != "Sol Workers' Party" {
+		t.Fatalf("Unexpected suggestions: %v", suggested)
+	}
+}
+
+func TestFindCommodityPadAndLocalDistance(t *testing.T) {
+	info := buildTradeEDDBInfo()
+	port := (*info.stations)[1]
+	port.MaxLandingPad = ""
+	port.DistanceToStar = 5000
+
+	for _, c := range []struct {
+		minPad       string
+		maxLocalDist float64
+		found        bool
+	}{
+		{"S", 0, true},
+		{"M", 0, true},
+		{"L", 0, false},
+		{"", 0, true},
+		{"S", 1000, false},
+		{"S", 5000, true},
+	} {
+		points, err := info.FindCommodity("gold", "Alpha", 1, c.minPad, true, c.maxLocalDist, 100, 3600)
+		if err != nil {
+			t.Fatalf("FindCommodity failed: %v", err)
+		}
+		if found := len(points) == 1 && points[0].station.Name == "Alpha Port"; found != c.found {
+			t.Errorf("Pad %q, local distance %g: expected found %v, got %d points", c.minPad, c.maxLocalDist, c.found, len(points))
+		}
+	}
+}
@@ -83,7 +83,6 @@ type SystemRecordV5 struct {
 	ReserveTypeId               int                            `json:"reserve_type_id"`
 	ReserveType                 string                         `json:"reserve_type"`
 	FactionPresences            []MinorFactionPresenceRecordV5 `json:"minor_faction_presences"`
-	stations                    *map[int]*StationRecordV5
 }
 
 type StationRecordV5 struct {
@@ -126,6 +125,7 @@ type StationRecordV5 struct {
 	SettlementSecurity      string   `json:"settlement_security"`
 	BodyId                  int      `json:"body_id"`
 	ControllingMinorFaction int      `json:"controlling_minor_faction_id"`
+	EdMarketId              int64    `json:"ed_market_id"`
 }
 
 type FactionRecordV5 struct {
@@ -142,6 +142,17 @@ type FactionRecordV5 struct {
 	IsPlayerFaction bool   `json:"is_player_faction"`
 }
 
+type BodyRecordV5 struct {
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	SystemID      int     `json:"system_id"`
+	GroupName     string  `json:"group_name"`
+	TypeName      string  `json:"type_name"`
+	SpectralClass string  `json:"spectral_class"`
+	DistanceToArr float64 `json:"distance_to_arrival"`
+	IsMainStar    bool    `json:"is_main_star"`
+}
+
 func (cat CommodityCategoryRecordV5) String() string {
 	return fmt.Sprint("CommodityCategory(%d, '%s')", cat.Id, cat.Name)
 }
@@ -233,6 +244,34 @@ func ReadStationsFile(fn string) (*map[int]*StationRecordV5, error) {
 	return &m, nil
 }
 
+/*
+	The bodies dump is huge, only the main stars are kept.
+*/
+func ReadMainStarsFile(fn string) (*map[int]*BodyRecordV5, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	m := make(map[int]*BodyRecordV5)
+	for scanner.Scan() {
+		var b BodyRecordV5
+		if err := json.Unmarshal(scanner.Bytes(), &b); err != nil {
+			log.Printf("Error unmarshaling body: %v\n", err)
+			continue
+		}
+		if b.IsMainStar {
+			m[b.SystemID] = &b
+		}
+	}
+
+	return &m, scanner.Err()
+}
+
 func atoiEmptyZero(a string) (int, error) {
 	if len(a) > 0 {
 		return strconv.Atoi(a)
@@ -26,6 +26,7 @@ type DataCacheConfig struct {
 	Stations    CachedData
 	Commodities CachedData
 	Listings    CachedData
+	Bodies      CachedData
 	ProcessListings bool
 }
 
@@ -164,12 +165,16 @@ func (d *CachedData) downloadUpdate(tr *http.Transport) error {
 func (dc *DataCache) CheckForUpdates() ([]*CachedData, error) {
 	rv := make([]*CachedData, 0)
 
-	//	idx := 0
-	for _, item := range []*CachedData{&dc.cfg.Systems,
+	items := []*CachedData{&dc.cfg.Systems,
 		&dc.cfg.Stations,
 		&dc.cfg.Factions,
 		&dc.cfg.Commodities,
-		&dc.cfg.Listings} {
+		&dc.cfg.Listings}
+	if len(dc.cfg.Bodies.URL) > 0 {
+		items = append(items, &dc.cfg.Bodies)
+	}
+
+	for _, item := range items {
 		tbu, err := item.needUpdate(dc.tr)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,206 @@
+package eddb
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-zeromq/zmq4"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+/*
+	Where ShipStatCollector takes the EDDN messages from.
+	Next blocks until a message is available, io.EOF means the source is exhausted.
+*/
+type EDDNSource interface {
+	Next() (*EDDNMessage, error)
+	Close() error
+	/*
+		A live source never waits for the collector, the messages the collector
+		has no room for are dropped. The others wait.
+	*/
+	IsLive() bool
+}
+
+/*
+	A zlib compressed frame as the relay sends it
+*/
+func decodeEDDNFrame(frame io.Reader) (*EDDNMessage, error) {
+	r, err := zlib.NewReader(frame)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	// read up to the checksum, the recorded frames follow each other
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var m EDDNMessage
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+/*
+	The live ZeroMQ relay, redialed on failures
+*/
+type RelaySource struct {
+	relay   string
+	sub     zmq4.Socket
+	stopped int32
+}
+
+func NewRelaySource(relay string) *RelaySource {
+	if len(relay) == 0 {
+		relay = RELAY
+	}
+	return &RelaySource{relay: relay}
+}
+
+func (s *RelaySource) IsLive() bool {
+	return true
+}
+
+func (s *RelaySource) Close() error {
+	atomic.StoreInt32(&s.stopped, 1)
+	if s.sub != nil {
+		return s.sub.Close()
+	}
+	return nil
+}
+
+func (s *RelaySource) Next() (*EDDNMessage, error) {
+	for atomic.LoadInt32(&s.stopped) == 0 {
+		if s.sub == nil {
+			s.sub = zmq4.NewSub(context.Background())
+			s.sub.SetOption(zmq4.OptionSubscribe, "")
+			log.Printf("Dialing %s", s.relay)
+			if err := s.sub.Dial(s.relay); err != nil {
+				log.Printf("could not dial: %v", err)
+				s.sub.Close()
+				s.sub = nil
+				time.Sleep(5 * time.Second)
+				continue
+			}
+		}
+		msg, err := s.sub.Recv()
+		if err != nil {
+			log.Printf("could not recv: %v", err)
+			s.sub.Close()
+			s.sub = nil
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		m, err := decodeEDDNFrame(bytes.NewReader(msg.Bytes()))
+		if err != nil {
+			log.Printf("frame decode failed: %v", err)
+			continue
+		}
+		return m, nil
+	}
+	return nil, io.EOF
+}
+
+/*
+	Replays a recorded file: either the concatenated zlib frames as the relay
+	sends them or the plain JSON messages one per line, gzipped or not. The gaps between
+	the gateway timestamps are kept divided by speed, speed <= 0 replays
+	as fast as the collector takes the messages.
+*/
+type ReplaySource struct {
+	f        *os.File
+	r        *bufio.Reader
+	jsonl    bool
+	speed    float64
+	lastSent time.Time // the gateway time of the previous message
+	lastWall time.Time
+}
+
+func NewReplaySource(fileName string, speed float64) (*ReplaySource, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	s := &ReplaySource{f: f, r: bufio.NewReader(f), speed: speed}
+	if magic, err := s.r.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(s.r)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		s.r = bufio.NewReader(gz)
+	}
+	for {
+		b, err := s.r.Peek(1)
+		if err != nil {
+			break // an empty file is replayed as an empty one
+		}
+		if b[0] == ' ' || b[0] == '\t' || b[0] == '\r' || b[0] == '\n' {
+			s.r.ReadByte()
+			continue
+		}
+		s.jsonl = b[0] == '{'
+		break
+	}
+	return s, nil
+}
+
+func (s *ReplaySource) IsLive() bool {
+	return false
+}
+
+func (s *ReplaySource) Close() error {
+	return s.f.Close()
+}
+
+func (s *ReplaySource) read() (*EDDNMessage, error) {
+	if !s.jsonl {
+		if _, err := s.r.Peek(1); err != nil {
+			return nil, io.EOF
+		}
+		return decodeEDDNFrame(s.r)
+	}
+	for {
+		line, err := s.r.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			var m EDDNMessage
+			if jerr := json.Unmarshal(line, &m); jerr != nil {
+				return nil, jerr
+			}
+			return &m, nil
+		}
+		if err != nil {
+			return nil, io.EOF
+		}
+	}
+}
+
+func (s *ReplaySource) Next() (*EDDNMessage, error) {
+	m, err := s.read()
+	if err != nil {
+		if err != io.EOF {
+			err = errors.New("Replay failed: " + err.Error())
+		}
+		return nil, err
+	}
+	sent := m.Header.GatewayTimestamp
+	if s.speed > 0 && !s.lastSent.IsZero() && sent.After(s.lastSent) {
+		due := s.lastWall.Add(time.Duration(float64(sent.Sub(s.lastSent)) / s.speed))
+		time.Sleep(time.Until(due))
+	}
+	s.lastSent = sent
+	s.lastWall = time.Now()
+	return m, nil
+}
@@ -0,0 +1,114 @@
+package eddb
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/json"
+	"fmt"
+	"goed/edGalaxy"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func testEDDNMessages(gateway time.Time) [][]byte {
+	now := time.Now().UTC().Format(time.RFC3339)
+	msgs := [][]byte{
+		[]byte(fmt.Sprintf(`{"event":"FSDJump","StarSystem":"Sol","StarPos":[0,0,0],"timestamp":"%s"}`, now)),
+		[]byte(fmt.Sprintf(`{"event":"Docked","StarSystem":"Sol","StarPos":[0,0,0],"StationName":"Abraham Lincoln","timestamp":"%s"}`, now)),
+		[]byte(fmt.Sprintf(`{"event":"FSDJump","StarSystem":"Sol","StarPos":[0,0,0],"timestamp":"%s"}`, now)),
+	}
+	rv := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		m := EDDNMessage{SchemaRef: "https://eddn.edcd.io/schemas/journal/1", Message: msg}
+		m.Header.GatewayTimestamp = gateway.Add(time.Duration(i) * 100 * time.Millisecond)
+		rv[i], _ = json.Marshal(&m)
+	}
+	return rv
+}
+
+func replayToCollector(t *testing.T, src EDDNSource) {
+	c := NewShipStatCollector()
+	c.SetSource(src)
+	c.StartListen()
+	defer c.Shutdown()
+
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		stat, _, _ := c.GetSystemVisitsStat(&edGalaxy.Point3D{}, 1, 0, 10)
+		// the jumps and the docks go through the separate channels, a dock may come first
+		if len(stat) == 1 && stat[0].Count >= 2 {
+			activity := c.GetActivityStat(&edGalaxy.Point3D{}, 1, 0)
+			if activity[0].NumDocks == 1 {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	stat, _, _ := c.GetSystemVisitsStat(&edGalaxy.Point3D{}, 1, 0, 10)
+	t.Fatalf("The replayed messages did not reach the collector: %d systems", len(stat))
+}
+
+func TestReplaySource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eddnreplay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	msgs := testEDDNMessages(time.Now())
+
+	jsonl := writeTestFile(t, dir, "eddn.jsonl", string(bytes.Join(msgs, []byte("\n"))))
+	src, err := NewReplaySource(jsonl, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayToCollector(t, src)
+
+	var frames bytes.Buffer
+	for _, msg := range msgs {
+		w := zlib.NewWriter(&frames)
+		w.Write(msg)
+		w.Close()
+	}
+	framesFile := writeTestFile(t, dir, "eddn.zlib", frames.String())
+	src, err = NewReplaySource(framesFile, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayToCollector(t, src)
+}
+
+func TestReplaySourceSpeed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eddnreplay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fn := writeTestFile(t, dir, "eddn.jsonl", string(bytes.Join(testEDDNMessages(time.Now()), []byte("\n"))))
+	// 200ms recorded, replayed twice as fast
+	src, err := NewReplaySource(fn, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+	started := time.Now()
+	n := 0
+	for {
+		if _, err = src.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Fatalf("Expected 3 messages, got %d", n)
+	}
+	if elapsed := time.Since(started); elapsed < 90*time.Millisecond {
+		t.Fatalf("Replayed too fast: %v", elapsed)
+	}
+}
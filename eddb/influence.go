@@ -0,0 +1,254 @@
+package eddb
+
+import (
+	"encoding/json"
+	"errors"
+	"goed/edGalaxy"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	secondsPerDay = 24 * 60 * 60
+)
+
+/*
+	Daily influence of one faction in one system,
+	the points are sorted by the timestamp.
+*/
+type factionSystemInfluence struct {
+	Faction string                     `json:"faction"`
+	System  string                     `json:"system"`
+	Points  []*edGalaxy.InfluencePoint `json:"points"`
+}
+
+/*
+	InfluenceHistory keeps the daily faction influence reported by FSDJumps.
+	The last report of the day wins.
+*/
+type InfluenceHistory struct {
+	mtx        sync.RWMutex
+	maxDays    int
+	bySystem   map[string]map[string]*factionSystemInfluence // upper system -> upper faction
+	byFaction  map[string]map[string]*factionSystemInfluence // upper faction -> upper system
+	lastReport map[string]time.Time                          // upper system -> the latest applied jump
+}
+
+func NewInfluenceHistory(maxDays int) *InfluenceHistory {
+	return &InfluenceHistory{
+		maxDays:    maxDays,
+		bySystem:   make(map[string]map[string]*factionSystemInfluence),
+		byFaction:  make(map[string]map[string]*factionSystemInfluence),
+		lastReport: make(map[string]time.Time)}
+}
+
+func (h *InfluenceHistory) getSeries(system, faction string) *factionSystemInfluence {
+	us, uf := strings.ToUpper(system), strings.ToUpper(faction)
+	factions, exists := h.bySystem[us]
+	if !exists {
+		factions = make(map[string]*factionSystemInfluence)
+		h.bySystem[us] = factions
+	}
+	fsi, exists := factions[uf]
+	if !exists {
+		fsi = &factionSystemInfluence{Faction: faction, System: system, Points: make([]*edGalaxy.InfluencePoint, 0)}
+		factions[uf] = fsi
+		systems, exists := h.byFaction[uf]
+		if !exists {
+			systems = make(map[string]*factionSystemInfluence)
+			h.byFaction[uf] = systems
+		}
+		systems[us] = fsi
+	}
+	return fsi
+}
+
+func (fsi *factionSystemInfluence) notePoint(p *edGalaxy.InfluencePoint, oldest int64) {
+	n := len(fsi.Points)
+	if n > 0 && fsi.Points[n-1].Timestamp == p.Timestamp {
+		fsi.Points[n-1] = p
+	} else if n == 0 || fsi.Points[n-1].Timestamp < p.Timestamp {
+		fsi.Points = append(fsi.Points, p)
+	} else {
+		return // a late report of the previous days
+	}
+	drop := 0
+	for drop < len(fsi.Points) && fsi.Points[drop].Timestamp < oldest {
+		drop++
+	}
+	if drop > 0 {
+		fsi.Points = append([]*edGalaxy.InfluencePoint{}, fsi.Points[drop:]...)
+	}
+}
+
+/*
+	FSDJumpListener implementation
+*/
+func (h *InfluenceHistory) OnFSDJump(_ *EDDNMessage, jump *FSDJumpMessage) {
+	if len(jump.Factions) == 0 {
+		return
+	}
+	us := strings.ToUpper(jump.StarSystem)
+	day := jump.Timestamp.Unix() / secondsPerDay * secondsPerDay
+	oldest := day - int64(h.maxDays-1)*secondsPerDay
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if last, exists := h.lastReport[us]; exists && last.After(jump.Timestamp) {
+		return
+	}
+	h.lastReport[us] = jump.Timestamp
+	for _, f := range jump.Factions {
+		p := &edGalaxy.InfluencePoint{
+			Timestamp:        day,
+			Influence:        f.Influence,
+			State:            eddnSymbol2name(f.FactionState),
+			PendingStates:    make([]string, len(f.PendingStates)),
+			RecoveringStates: make([]string, len(f.RecoveringStates))}
+		for i, ps := range f.PendingStates {
+			p.PendingStates[i] = eddnSymbol2name(ps.State)
+		}
+		for i, rs := range f.RecoveringStates {
+			p.RecoveringStates[i] = eddnSymbol2name(rs.State)
+		}
+		h.getSeries(jump.StarSystem, f.Name).notePoint(p, oldest)
+	}
+}
+
+func collectInfluenceSeries(name string, series map[string]*factionSystemInfluence, bySystem bool, days int) *edGalaxy.InfluenceHistory {
+	oldest := (time.Now().Unix()/secondsPerDay - int64(days-1)) * secondsPerDay
+	rv := &edGalaxy.InfluenceHistory{Name: name, Series: make([]*edGalaxy.InfluenceSeries, 0, len(series))}
+	for _, fsi := range series {
+		s := &edGalaxy.InfluenceSeries{Name: fsi.System, Points: make([]*edGalaxy.InfluencePoint, 0, len(fsi.Points))}
+		if bySystem {
+			s.Name = fsi.Faction
+			rv.Name = fsi.System
+		} else {
+			rv.Name = fsi.Faction
+		}
+		for _, p := range fsi.Points {
+			if p.Timestamp >= oldest {
+				s.Points = append(s.Points, p)
+			}
+		}
+		if len(s.Points) > 0 {
+			rv.Series = append(rv.Series, s)
+		}
+	}
+	sort.Slice(rv.Series, func(i, j int) bool {
+		return rv.Series[i].Name < rv.Series[j].Name
+	})
+	return rv
+}
+
+/*
+	The points are never modified once stored, so they are shared with the caller
+*/
+func (h *InfluenceHistory) GetFactionInfluenceHistory(faction string, days int) (*edGalaxy.InfluenceHistory, error) {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	series, exists := h.byFaction[strings.ToUpper(faction)]
+	if !exists {
+		return nil, errors.New("No influence reports on the faction")
+	}
+	return collectInfluenceSeries(faction, series, false, days), nil
+}
+
+func (h *InfluenceHistory) GetSystemInfluenceHistory(system string, days int) (*edGalaxy.InfluenceHistory, error) {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	series, exists := h.bySystem[strings.ToUpper(system)]
+	if !exists {
+		return nil, errors.New("No influence reports on the system")
+	}
+	return collectInfluenceSeries(system, series, true, days), nil
+}
+
+/*
+	Drops the series with no points for maxAge, the systems
+	the factions left or nobody visits, and the older points of the rest
+*/
+func (h *InfluenceHistory) Expire(maxAge time.Duration) {
+	oldest := time.Now().Add(-maxAge).Unix() / secondsPerDay * secondsPerDay
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for us, factions := range h.bySystem {
+		for uf, fsi := range factions {
+			drop := 0
+			for drop < len(fsi.Points) && fsi.Points[drop].Timestamp < oldest {
+				drop++
+			}
+			if drop < len(fsi.Points) {
+				if drop > 0 {
+					fsi.Points = append([]*edGalaxy.InfluencePoint{}, fsi.Points[drop:]...)
+				}
+				continue
+			}
+			delete(factions, uf)
+			if systems := h.byFaction[uf]; systems != nil {
+				delete(systems, us)
+				if len(systems) == 0 {
+					delete(h.byFaction, uf)
+				}
+			}
+		}
+		if len(factions) == 0 {
+			delete(h.bySystem, us)
+			delete(h.lastReport, us)
+		}
+	}
+}
+
+/*
+	Writes a series per line
+*/
+func (h *InfluenceHistory) Backup(fileName string) bool {
+	h.mtx.RLock()
+	err := writeJSONLines(fileName, func(enc *json.Encoder) error {
+		for _, factions := range h.bySystem {
+			for _, fsi := range factions {
+				if err := enc.Encode(fsi); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	h.mtx.RUnlock()
+	if err != nil {
+		log.Printf("Influence backup to %s failed: %v\n", fileName, err)
+		return false
+	}
+	log.Printf("Influence backup to %s succeeded\n", fileName)
+	return true
+}
+
+/*
+	The current history is kept if the file can not be read to the end
+*/
+func (h *InfluenceHistory) Restore(fileName string) bool {
+	restored := NewInfluenceHistory(h.maxDays)
+	cnt := 0
+	_, err := readJSONLines(fileName, func(line []byte) error {
+		var fsi factionSystemInfluence
+		if err := json.Unmarshal(line, &fsi); err != nil {
+			log.Printf("Error unmarshaling influence: %v\n", err)
+			return err
+		}
+		restored.getSeries(fsi.System, fsi.Faction).Points = fsi.Points
+		cnt++
+		return nil
+	})
+	if err != nil {
+		log.Printf("Influence restore from %s failed: %v\n", fileName, err)
+		return false
+	}
+	h.mtx.Lock()
+	h.bySystem, h.byFaction, h.lastReport = restored.bySystem, restored.byFaction, restored.lastReport
+	h.mtx.Unlock()
+	log.Printf("Influence restore from %s succeeded: got %d series\n", fileName, cnt)
+	return true
+}
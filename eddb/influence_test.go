@@ -0,0 +1,71 @@
+package eddb
+
+import (
+	"testing"
+	"time"
+)
+
+func influenceJump(system string, ts time.Time, influences map[string]float64) *FSDJumpMessage {
+	jump := &FSDJumpMessage{StarSystem: system, StarPos: []float64{0, 0, 0}, Timestamp: ts}
+	for name, inf := range influences {
+		jump.Factions = append(jump.Factions, FSDJumpFaction{Name: name, Influence: inf, FactionState: "None"})
+	}
+	return jump
+}
+
+func TestInfluenceHistory(t *testing.T) {
+	h := NewInfluenceHistory(30)
+	now := time.Now().UTC().Truncate(24 * time.Hour).Add(12 * time.Hour)
+	yesterday := now.Add(-24 * time.Hour)
+
+	h.OnFSDJump(nil, influenceJump("Alpha", yesterday, map[string]float64{"Pilots": 0.3, "Miners": 0.7}))
+	h.OnFSDJump(nil, influenceJump("Alpha", now, map[string]float64{"Pilots": 0.35, "Miners": 0.65}))
+	h.OnFSDJump(nil, influenceJump("Alpha", now.Add(time.Second), map[string]float64{"Pilots": 0.4, "Miners": 0.6}))
+	h.OnFSDJump(nil, influenceJump("Beta", now, map[string]float64{"Pilots": 0.9}))
+	// late, ignored
+	h.OnFSDJump(nil, influenceJump("Alpha", now.Add(-time.Second), map[string]float64{"Pilots": 0.1, "Miners": 0.9}))
+
+	check := func(h *InfluenceHistory) {
+		fh, err := h.GetFactionInfluenceHistory("pilots", 7)
+		if err != nil {
+			t.Fatalf("GetFactionInfluenceHistory failed: %v", err)
+		}
+		if fh.Name != "Pilots" || len(fh.Series) != 2 || fh.Series[0].Name != "Alpha" || fh.Series[1].Name != "Beta" {
+			t.Fatalf("Unexpected history: %+v", fh)
+		}
+		alpha := fh.Series[0].Points
+		if len(alpha) != 2 || alpha[0].Influence != 0.3 || alpha[1].Influence != 0.4 {
+			t.Fatalf("Expected a point per day, the last report wins: %+v", alpha)
+		}
+		sh, err := h.GetSystemInfluenceHistory("ALPHA", 1)
+		if err != nil || len(sh.Series) != 2 || len(sh.Series[0].Points) != 1 || sh.Series[0].Name != "Miners" {
+			t.Fatalf("Unexpected system history: %+v %v", sh, err)
+		}
+	}
+	check(h)
+
+	restored := NewInfluenceHistory(30)
+	testRoundTrip(t, h.Backup, restored.Restore)
+	check(restored)
+}
+
+func TestInfluenceHistoryExpire(t *testing.T) {
+	h := NewInfluenceHistory(30)
+	now := time.Now().UTC().Truncate(24 * time.Hour).Add(12 * time.Hour)
+
+	h.OnFSDJump(nil, influenceJump("Alpha", now.Add(-20*24*time.Hour), map[string]float64{"Pilots": 0.3, "Miners": 0.7}))
+	h.OnFSDJump(nil, influenceJump("Alpha", now, map[string]float64{"Pilots": 0.4}))
+	h.OnFSDJump(nil, influenceJump("Beta", now.Add(-15*24*time.Hour), map[string]float64{"Miners": 0.9}))
+
+	h.Expire(10 * 24 * time.Hour)
+	if _, err := h.GetSystemInfluenceHistory("Beta", 30); err == nil {
+		t.Errorf("The system nobody visits is not dropped")
+	}
+	if _, err := h.GetFactionInfluenceHistory("Miners", 30); err == nil {
+		t.Errorf("The faction with no fresh reports is not dropped")
+	}
+	fh, err := h.GetFactionInfluenceHistory("Pilots", 30)
+	if err != nil || len(fh.Series) != 1 || len(fh.Series[0].Points) != 1 || fh.Series[0].Points[0].Influence != 0.4 {
+		t.Fatalf("Unexpected history: %+v %v", fh, err)
+	}
+}
@@ -0,0 +1,218 @@
+package eddb
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	eventLocation    = "Location"
+	eventCarrierJump = "CarrierJump"
+	eventScan        = "Scan"
+	eventCommodity   = "Commodity" // not a journal event, the commodity schema message
+
+	commoditySchema = "/schemas/commodity/3"
+)
+
+type eddnEvent struct {
+	name string
+	m    *EDDNMessage
+}
+
+/*
+	Location and CarrierJump carry the same system state as FSDJump
+*/
+type LocationMessage struct {
+	FSDJumpMessage
+	Docked          bool     `json:"Docked"`
+	StationName     string   `json:"StationName,omitempty"`
+	StationType     string   `json:"StationType,omitempty"`
+	MarketID        int64    `json:"MarketID,omitempty"`
+	Body            string   `json:"Body,omitempty"`
+	BodyType        string   `json:"BodyType,omitempty"`
+	StationServices []string `json:"StationServices,omitempty"` // CarrierJump only
+}
+
+/*
+	The carrier jumps with its docked owner or the visitors, StationName is the carrier callsign
+*/
+type CarrierJumpMessage struct {
+	LocationMessage
+}
+
+type ScanMessage struct {
+	BodyName              string    `json:"BodyName"`
+	BodyID                int       `json:"BodyID"`
+	StarSystem            string    `json:"StarSystem"`
+	StarPos               []float64 `json:"StarPos"`
+	SystemAddress         int64     `json:"SystemAddress"`
+	DistanceFromArrivalLS float64   `json:"DistanceFromArrivalLS"`
+	StarType              string    `json:"StarType,omitempty"`
+	Subclass              int       `json:"Subclass,omitempty"`
+	StellarMass           float64   `json:"StellarMass,omitempty"`
+	PlanetClass           string    `json:"PlanetClass,omitempty"`
+	Atmosphere            string    `json:"Atmosphere,omitempty"`
+	TerraformState        string    `json:"TerraformState,omitempty"`
+	Volcanism             string    `json:"Volcanism,omitempty"`
+	MassEM                float64   `json:"MassEM,omitempty"`
+	Radius                float64   `json:"Radius,omitempty"`
+	SurfaceGravity        float64   `json:"SurfaceGravity,omitempty"`
+	SurfaceTemperature    float64   `json:"SurfaceTemperature,omitempty"`
+	Landable              bool      `json:"Landable,omitempty"`
+	Rings                 []struct {
+		Name      string `json:"Name"`
+		RingClass string `json:"RingClass"`
+	} `json:"Rings,omitempty"`
+	WasDiscovered bool      `json:"WasDiscovered"`
+	WasMapped     bool      `json:"WasMapped"`
+	ScanType      string    `json:"ScanType,omitempty"`
+	Event         string    `json:"event"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+func (s *ScanMessage) IsStar() bool {
+	return len(s.StarType) > 0
+}
+
+type CommodityPrice struct {
+	Name          string `json:"name"`
+	BuyPrice      int    `json:"buyPrice"`
+	SellPrice     int    `json:"sellPrice"`
+	MeanPrice     int    `json:"meanPrice"`
+	Stock         int    `json:"stock"`
+	StockBracket  int    `json:"stockBracket"`
+	Demand        int    `json:"demand"`
+	DemandBracket int    `json:"demandBracket"`
+}
+
+/*
+	The brackets are either numbers or "" in the feed
+*/
+func (p *CommodityPrice) UnmarshalJSON(data []byte) error {
+	type plain CommodityPrice
+	var raw struct {
+		plain
+		StockBracket  json.RawMessage `json:"stockBracket"`
+		DemandBracket json.RawMessage `json:"demandBracket"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*p = CommodityPrice(raw.plain)
+	json.Unmarshal(raw.StockBracket, &p.StockBracket)
+	json.Unmarshal(raw.DemandBracket, &p.DemandBracket)
+	return nil
+}
+
+/*
+	The commodity/3 schema message: the whole market of a station
+*/
+type CommodityMessage struct {
+	SystemName  string           `json:"systemName"`
+	StationName string           `json:"stationName"`
+	MarketID    int64            `json:"marketId"`
+	Horizons    bool             `json:"horizons"`
+	Odyssey     bool             `json:"odyssey"`
+	Commodities []CommodityPrice `json:"commodities"`
+	Prohibited  []string         `json:"prohibited,omitempty"`
+	Timestamp   time.Time        `json:"timestamp"`
+}
+
+/*
+	Gets every parsed Scan, called from the collector goroutine
+*/
+type ScanListener interface {
+	OnScan(m *EDDNMessage, scan *ScanMessage)
+}
+
+/*
+	Gets every parsed CarrierJump, called from the collector goroutine.
+	The system state of the jump goes to FSDJumpListener as well.
+*/
+type CarrierJumpListener interface {
+	OnCarrierJump(m *EDDNMessage, jump *CarrierJumpMessage)
+}
+
+/*
+	Gets every parsed commodity market, called from the collector goroutine
+*/
+type MarketListener interface {
+	OnMarket(m *EDDNMessage, market *CommodityMessage)
+}
+
+func (c *ShipStatCollector) AddScanListener(l ScanListener) {
+	c.scanListeners = append(c.scanListeners, l)
+}
+
+func (c *ShipStatCollector) AddCarrierJumpListener(l CarrierJumpListener) {
+	c.carrierJumpListeners = append(c.carrierJumpListeners, l)
+}
+
+func (c *ShipStatCollector) AddMarketListener(l MarketListener) {
+	c.marketListeners = append(c.marketListeners, l)
+}
+
+func (c *ShipStatCollector) noteJournalEvent(e *eddnEvent, wait bool) error {
+	if wait {
+		c.journal <- e
+		return nil
+	}
+	select {
+	case c.journal <- e:
+	default:
+		atomic.AddUint64(&c.dropped, 1)
+		eddnDropped.With("journal").Inc()
+		return errors.New("Journal channel is busy")
+	}
+	return nil
+}
+
+func (c *ShipStatCollector) handleJournalEvent(e *eddnEvent) {
+	switch e.name {
+	case eventLocation:
+		var loc LocationMessage
+		if err := json.Unmarshal(e.m.Message, &loc); err != nil {
+			log.Printf("json Location message failed: %v\n", err)
+			return
+		}
+		c.noteSystemState(e.m, &loc.FSDJumpMessage, false)
+	case eventCarrierJump:
+		var jump CarrierJumpMessage
+		if err := json.Unmarshal(e.m.Message, &jump); err != nil {
+			log.Printf("json CarrierJump message failed: %v\n", err)
+			return
+		}
+		// whoever is aboard arrives to the system
+		c.noteSystemState(e.m, &jump.FSDJumpMessage, true)
+		for _, l := range c.carrierJumpListeners {
+			l.OnCarrierJump(e.m, &jump)
+		}
+	case eventScan:
+		if len(c.scanListeners) == 0 {
+			return
+		}
+		var scan ScanMessage
+		if err := json.Unmarshal(e.m.Message, &scan); err != nil {
+			log.Printf("json Scan message failed: %v\n", err)
+			return
+		}
+		for _, l := range c.scanListeners {
+			l.OnScan(e.m, &scan)
+		}
+	case eventCommodity:
+		if len(c.marketListeners) == 0 {
+			return
+		}
+		var market CommodityMessage
+		if err := json.Unmarshal(e.m.Message, &market); err != nil {
+			log.Printf("json commodity message failed: %v\n", err)
+			return
+		}
+		for _, l := range c.marketListeners {
+			l.OnMarket(e.m, &market)
+		}
+	}
+}
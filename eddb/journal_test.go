@@ -0,0 +1,103 @@
+package eddb
+
+import (
+	"goed/edGalaxy"
+	"io"
+	"testing"
+	"time"
+)
+
+type testMarketListener struct {
+	markets []*CommodityMessage
+}
+
+func (l *testMarketListener) OnMarket(_ *EDDNMessage, market *CommodityMessage) {
+	l.markets = append(l.markets, market)
+}
+
+type eddnMessageSource struct {
+	messages []*EDDNMessage
+}
+
+func (s *eddnMessageSource) Next() (*EDDNMessage, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	m := s.messages[0]
+	s.messages = s.messages[1:]
+	return m, nil
+}
+
+func (s *eddnMessageSource) Close() error { return nil }
+func (s *eddnMessageSource) IsLive() bool { return false }
+
+func TestJournalEvents(t *testing.T) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	journal := "https://eddn.edcd.io/schemas/journal/1"
+	src := &eddnMessageSource{messages: []*EDDNMessage{
+		{SchemaRef: journal, Message: []byte(`{"event":"CarrierJump","StarSystem":"Sol","StarPos":[0,0,0],"StationName":"X9Z-B0B","MarketID":3700000000,"Docked":true,"timestamp":"` + now + `"}`)},
+		{SchemaRef: journal, Message: []byte(`{"event":"Location","StarSystem":"Sol","StarPos":[0,0,0],"timestamp":"` + now + `"}`)},
+		{SchemaRef: journal, Message: []byte(`{"event":"Scan","StarSystem":"Sol","StarPos":[0,0,0],"BodyName":"Earth","BodyID":3,"PlanetClass":"Earthlike body","DistanceFromArrivalLS":500,"timestamp":"` + now + `"}`)},
+		{SchemaRef: journal, Message: []byte(`{"event":"Scan","StarSystem":"Sol","StarPos":[0,0,0],"BodyName":"Sol","BodyID":0,"StarType":"G","Rings":[{"Name":"Sol A Belt","RingClass":"eRingClass_Rocky"}],"timestamp":"` + now + `"}`)},
+		{SchemaRef: "https://eddn.edcd.io/schemas/commodity/3", Message: []byte(`{"systemName":"Sol","stationName":"Abraham Lincoln","marketId":128016640,"timestamp":"` + now + `",` +
+			`"commodities":[{"name":"gold","buyPrice":9000,"sellPrice":8800,"meanPrice":9400,"stock":120,"stockBracket":2,"demand":0,"demandBracket":""}]}`)},
+	}}
+
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	bodies := NewBodyRegistry(10)
+	markets := &testMarketListener{}
+	c.AddScanListener(bodies)
+	c.AddMarketListener(markets)
+	if n, _ := c.Replay(src); n != 5 {
+		t.Fatalf("Expected 5 messages replayed, got %d", n)
+	}
+
+	// the carrier jump is a visit, the location is not
+	stat, _, _ := c.GetSystemVisitsStat(&edGalaxy.Point3D{}, 1, 0, 10)
+	if len(stat) != 1 || stat[0].Count != 1 {
+		t.Fatalf("Expected one visit of Sol, got %+v", stat)
+	}
+
+	sol, err := bodies.GetSystemBodies("sol")
+	if err != nil || len(sol) != 2 {
+		t.Fatalf("Expected 2 bodies in Sol: %v", err)
+	}
+	if !sol[0].IsStar || sol[0].Type != "G" || len(sol[0].RingClasses) != 1 || sol[0].RingClasses[0] != "Rocky" {
+		t.Fatalf("Unexpected star: %+v", sol[0])
+	}
+	if sol[1].Name != "Earth" || sol[1].Type != "Earthlike body" {
+		t.Fatalf("Unexpected planet: %+v", sol[1])
+	}
+
+	if len(markets.markets) != 1 {
+		t.Fatalf("Expected one market, got %d", len(markets.markets))
+	}
+	gold := markets.markets[0].Commodities[0]
+	if markets.markets[0].MarketID != 128016640 || gold.StockBracket != 2 || gold.DemandBracket != 0 || gold.BuyPrice != 9000 {
+		t.Fatalf("Unexpected market: %+v", gold)
+	}
+}
+
+func TestBodyRegistryKeepsRecentSystems(t *testing.T) {
+	r := NewBodyRegistry(2)
+	for _, s := range []string{"Sol", "Achenar", "Sol", "Lave"} {
+		r.OnScan(nil, &ScanMessage{StarSystem: s, BodyName: s, Timestamp: time.Now()})
+	}
+	if _, err := r.GetSystemBodies("Achenar"); err == nil {
+		t.Fatalf("Achenar is to be forgotten")
+	}
+	for _, s := range []string{"Sol", "Lave"} {
+		if _, err := r.GetSystemBodies(s); err != nil {
+			t.Fatalf("%s is to be kept", s)
+		}
+	}
+}
+
+func TestBodyRegistryDefaultLimit(t *testing.T) {
+	for _, max := range []int{0, -1} {
+		if r := NewBodyRegistry(max); r.maxSystems != defaultMaxScannedSystems {
+			t.Errorf("NewBodyRegistry(%d) keeps %d systems, expected %d", max, r.maxSystems, defaultMaxScannedSystems)
+		}
+	}
+}
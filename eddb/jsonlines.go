@@ -0,0 +1,58 @@
+package eddb
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+)
+
+// the busy systems with many stations make long lines
+const maxJSONLine = 16 * 1024 * 1024
+
+/*
+	The backups are a JSON record per line. encode writes the records to
+	the temporary file, it is renamed to fileName when complete, so the
+	previous backup stays intact on failure.
+*/
+func writeJSONLines(fileName string, encode func(enc *json.Encoder) error) error {
+	tmpName := fileName + ".tmp"
+	f, err := os.Create(tmpName)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	err = encode(json.NewEncoder(w))
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return err
+	}
+	return os.Rename(tmpName, fileName)
+}
+
+/*
+	Calls decode for every line. A line decode fails on is skipped and counted
+	as bad, the error is returned only if the file can not be read to the end.
+*/
+func readJSONLines(fileName string, decode func(line []byte) error) (bad int, err error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), maxJSONLine)
+	for scanner.Scan() {
+		if decode(scanner.Bytes()) != nil {
+			bad++
+		}
+	}
+	return bad, scanner.Err()
+}
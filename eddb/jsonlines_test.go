@@ -0,0 +1,85 @@
+package eddb
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+	Backs a store up to a temporary file and restores the other store from it
+*/
+func testRoundTrip(t *testing.T, backup func(fileName string) bool, restore func(fileName string) bool) {
+	t.Helper()
+	fileName := filepath.Join(t.TempDir(), "backup.jsonl")
+	if !backup(fileName) {
+		t.Fatalf("Backup failed")
+	}
+	if !restore(fileName) {
+		t.Fatalf("Restore failed")
+	}
+}
+
+func TestJSONLines(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "lines.jsonl")
+	long := strings.Repeat("x", 1024*1024) // over the default scanner buffer
+	records := []string{"a", long, "c"}
+	err := writeJSONLines(fileName, func(enc *json.Encoder) error {
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("writeJSONLines failed: %v", err)
+	}
+	if _, err = os.Stat(fileName + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("The temporary file is left: %v", err)
+	}
+
+	// the failed write keeps the previous file
+	if err = writeJSONLines(fileName, func(enc *json.Encoder) error {
+		enc.Encode("partial")
+		return errors.New("Failed")
+	}); err == nil {
+		t.Errorf("The encode error is lost")
+	}
+
+	f, err := os.OpenFile(fileName, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("{broken\n")
+	f.Close()
+
+	read := make([]string, 0)
+	bad, err := readJSONLines(fileName, func(line []byte) error {
+		var r string
+		if err := json.Unmarshal(line, &r); err != nil {
+			return err
+		}
+		read = append(read, r)
+		return nil
+	})
+	if err != nil || bad != 1 {
+		t.Fatalf("Unexpected read: %d bad, %v", bad, err)
+	}
+	if len(read) != 3 || read[0] != "a" || read[1] != long || read[2] != "c" {
+		t.Errorf("Unexpected records: %d", len(read))
+	}
+
+	if _, err = readJSONLines(filepath.Join(t.TempDir(), "missing.jsonl"), func([]byte) error { return nil }); err == nil {
+		t.Errorf("The missing file is read")
+	}
+	if ioutil.WriteFile(fileName, []byte(strings.Repeat("x", maxJSONLine+1)), 0644) == nil {
+		if _, err = readJSONLines(fileName, func([]byte) error { return nil }); err == nil {
+			t.Errorf("The line over the limit is read")
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package eddb
+
+import (
+	"goed/edGalaxy"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+/*
+	The overlay over the nightly dump: the latest system states
+	reported via EDDN, by the upper case system name.
+*/
+type LiveSystemStates struct {
+	mtx    sync.RWMutex
+	states map[string]*edGalaxy.LiveSystemState
+}
+
+func NewLiveSystemStates() *LiveSystemStates {
+	return &LiveSystemStates{states: make(map[string]*edGalaxy.LiveSystemState)}
+}
+
+/*
+	"$government_Corporate;" -> "Corporate", "$SYSTEM_SECURITY_high;" -> "High",
+	"CivilWar" -> "Civil War", like the EDDB dump has it
+*/
+func eddnSymbol2name(s string) string {
+	if strings.HasPrefix(s, "$") {
+		s = strings.TrimSuffix(s[1:], ";")
+		if pos := strings.LastIndex(s, "_"); pos >= 0 {
+			s = s[pos+1:]
+		}
+	}
+	var b strings.Builder
+	for i, r := range s {
+		if i == 0 {
+			r = unicode.ToUpper(r)
+		} else if unicode.IsUpper(r) && !unicode.IsUpper(rune(s[i-1])) {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func fsdJump2liveSystemState(jump *FSDJumpMessage) *edGalaxy.LiveSystemState {
+	state := &edGalaxy.LiveSystemState{
+		Name:       jump.StarSystem,
+		Population: jump.Population,
+		Allegiance: jump.SystemAllegiance,
+		Government: eddnSymbol2name(jump.SystemGovernment),
+		Security:   eddnSymbol2name(jump.SystemSecurity),
+		Economy:    eddnSymbol2name(jump.SystemEconomy),
+		Faction:    jump.SystemFaction,
+		Factions:   make([]*edGalaxy.LiveFactionState, len(jump.Factions)),
+		Timestamp:  jump.Timestamp}
+	if len(jump.StarPos) == 3 {
+		state.Coords = &edGalaxy.Point3D{X: jump.StarPos[0], Y: jump.StarPos[1], Z: jump.StarPos[2]}
+	}
+	for i, f := range jump.Factions {
+		fs := &edGalaxy.LiveFactionState{
+			Name:             f.Name,
+			Allegiance:       f.Allegiance,
+			Government:       f.Government,
+			State:            eddnSymbol2name(f.FactionState),
+			Influence:        f.Influence,
+			PendingStates:    make([]string, len(f.PendingStates)),
+			RecoveringStates: make([]string, len(f.RecoveringStates))}
+		for j, ps := range f.PendingStates {
+			fs.PendingStates[j] = eddnSymbol2name(ps.State)
+		}
+		for j, rs := range f.RecoveringStates {
+			fs.RecoveringStates[j] = eddnSymbol2name(rs.State)
+		}
+		state.Factions[i] = fs
+		if f.Name == jump.SystemFaction {
+			state.FactionState = fs.State
+		}
+	}
+	return state
+}
+
+/*
+	FSDJumpListener implementation, the late messages do not override the fresh ones
+*/
+func (l *LiveSystemStates) OnFSDJump(_ *EDDNMessage, jump *FSDJumpMessage) {
+	state := fsdJump2liveSystemState(jump)
+	nm := strings.ToUpper(jump.StarSystem)
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if known, exists := l.states[nm]; exists && known.Timestamp.After(state.Timestamp) {
+		return
+	}
+	l.states[nm] = state
+}
+
+/*
+	The states are never modified once stored, so they can be shared
+*/
+func (l *LiveSystemStates) Get(systemName string) (*edGalaxy.LiveSystemState, bool) {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	s, exists := l.states[strings.ToUpper(systemName)]
+	return s, exists
+}
+
+func (l *LiveSystemStates) Len() int {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	return len(l.states)
+}
+
+/*
+	Drops the states not reported for maxAge, every system jumped into
+	is reported so the map is to be pruned
+*/
+func (l *LiveSystemStates) Expire(maxAge time.Duration) {
+	oldest := time.Now().Add(-maxAge)
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for nm, s := range l.states {
+		if s.Timestamp.Before(oldest) {
+			delete(l.states, nm)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package eddb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEDDNSymbol2Name(t *testing.T) {
+	for in, expected := range map[string]string{
+		"$government_Corporate;":   "Corporate",
+		"$SYSTEM_SECURITY_medium;": "Medium",
+		"$economy_HighTech;":       "High Tech",
+		"CivilWar":                 "Civil War",
+		"Boom":                     "Boom",
+		"":                         "",
+	} {
+		if got := eddnSymbol2name(in); got != expected {
+			t.Errorf("%s: expected '%s', got '%s'", in, expected, got)
+		}
+	}
+}
+
+func TestLiveStateOverlay(t *testing.T) {
+	info := buildLineEDDBInfo()
+	(*info.systems)[5].State = "Boom"
+	(*info.systems)[5].Population = 1000
+	(*info.systems)[5].Updated = time.Now().Add(-24 * time.Hour).Unix()
+
+	live := NewLiveSystemStates()
+	info.SetLiveStates(live)
+
+	var jump FSDJumpMessage
+	raw := `{"StarSystem":"Line 40","StarPos":[40,0,0],"Population":5000,"SystemFaction":"Pilots",
+		"SystemSecurity":"$SYSTEM_SECURITY_low;","Factions":[{"Name":"Pilots","FactionState":"CivilWar","Influence":0.4}]}`
+	if err := json.Unmarshal([]byte(raw), &jump); err != nil {
+		t.Fatal(err)
+	}
+	jump.Timestamp = time.Now()
+	live.OnFSDJump(nil, &jump)
+
+	summary, _ := info.SystemSummaryByName("line 40")
+	if summary.BriefInfo.FactionState != "Civil War" || summary.BriefInfo.Security != "Low" || summary.LastEDDNUpdate != jump.Timestamp.Unix() {
+		t.Fatalf("The live state is not applied: %+v", summary.BriefInfo)
+	}
+
+	place, _ := info.GetSystemCoordsByName("Line 0")
+	if res := info.FindStates([]string{"boom"}, place, 100, 1000, 10); len(res) != 0 {
+		t.Fatalf("The dump state must be overridden")
+	}
+	res := info.FindStates([]string{"civil war"}, place, 2000, 1000, 10)
+	if len(res) != 1 || res[0].Population != 5000 || res[0].Factions[0].Name != "Pilots" || res[0].LastEDDNUpdate == 0 {
+		t.Fatalf("Expected Line 40 in civil war, got %v", res)
+	}
+
+	// late messages do not override
+	jump.Timestamp = jump.Timestamp.Add(-time.Hour)
+	jump.Population = 1
+	live.OnFSDJump(nil, &jump)
+	if ls, _ := live.Get("LINE 40"); ls.Population != 5000 {
+		t.Fatalf("The late message overrides the state")
+	}
+}
+
+func TestLiveStatesExpire(t *testing.T) {
+	live := NewLiveSystemStates()
+	live.OnFSDJump(nil, &FSDJumpMessage{StarSystem: "Sol", Timestamp: time.Now().Add(-3 * time.Hour)})
+	live.OnFSDJump(nil, &FSDJumpMessage{StarSystem: "Lave", Timestamp: time.Now()})
+
+	live.Expire(2 * time.Hour)
+	if live.Len() != 1 {
+		t.Fatalf("Expected 1 state, got %d", live.Len())
+	}
+	if _, exists := live.Get("Sol"); exists {
+		t.Error("Sol is to be expired")
+	}
+	if _, exists := live.Get("lave"); !exists {
+		t.Error("Lave is to be kept")
+	}
+}
@@ -0,0 +1,195 @@
+package eddb
+
+import (
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const (
+	PriceSourceEDDB = "EDDB"
+	PriceSourceEDDN = "EDDN"
+)
+
+/*
+	The EDDN symbols differing from the EDDB names,
+	both in the normalized form
+*/
+var commoditySymbolAliases = map[string]string{
+	"agriculturalmedicines":     "agrimedicines",
+	"basicnarcotics":            "narcotics",
+	"comercialsamples":          "commercialsamples",
+	"drones":                    "limpet",
+	"hazardousenvironmentsuits": "hesuits",
+	"heliostaticfurnaces":       "microbialfurnaces",
+	"lowtemperaturediamond":     "lowtemperaturediamonds",
+	"opal":                      "voidopals",
+	"trinketsoffortune":         "trinketsofhiddenfortune",
+}
+
+/*
+	"Low Temperature Diamonds" -> "lowtemperaturediamonds", the way EDDN names them
+*/
+func normalizeCommodityName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	n := b.String()
+	if alias, exists := commoditySymbolAliases[n]; exists {
+		return alias
+	}
+	return n
+}
+
+/*
+	The market snapshot as the latest commodity message has it
+*/
+type LiveMarket struct {
+	MarketID    int64            `json:"marketId"`
+	SystemName  string           `json:"systemName"`
+	StationName string           `json:"stationName"`
+	Updated     int64            `json:"updated"` // unix seconds
+	Commodities []CommodityPrice `json:"commodities"`
+	prices      map[string]*CommodityPrice
+}
+
+func (m *LiveMarket) index() {
+	m.prices = make(map[string]*CommodityPrice, len(m.Commodities))
+	for i := range m.Commodities {
+		m.prices[normalizeCommodityName(m.Commodities[i].Name)] = &m.Commodities[i]
+	}
+}
+
+/*
+	The price of the commodity by its EDDB name
+*/
+func (m *LiveMarket) Price(commodityName string) (*CommodityPrice, bool) {
+	p, exists := m.prices[normalizeCommodityName(commodityName)]
+	return p, exists
+}
+
+/*
+	The overlay over the listings of the dump: the latest market
+	snapshots reported via EDDN, by the market id.
+*/
+type LiveMarkets struct {
+	mtx     sync.RWMutex
+	markets map[int64]*LiveMarket
+}
+
+func NewLiveMarkets() *LiveMarkets {
+	return &LiveMarkets{markets: make(map[int64]*LiveMarket)}
+}
+
+/*
+	MarketListener implementation, a snapshot replaces the whole market.
+	The late messages do not override the fresh ones.
+*/
+func (l *LiveMarkets) OnMarket(_ *EDDNMessage, market *CommodityMessage) {
+	if market.MarketID == 0 {
+		return
+	}
+	m := &LiveMarket{
+		MarketID:    market.MarketID,
+		SystemName:  market.SystemName,
+		StationName: market.StationName,
+		Updated:     market.Timestamp.Unix(),
+		Commodities: market.Commodities}
+	m.index()
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if known, exists := l.markets[m.MarketID]; exists && known.Updated > m.Updated {
+		return
+	}
+	l.markets[m.MarketID] = m
+}
+
+/*
+	The markets are never modified once stored, so they can be shared
+*/
+func (l *LiveMarkets) Get(marketID int64) (*LiveMarket, bool) {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	m, exists := l.markets[marketID]
+	return m, exists
+}
+
+func (l *LiveMarkets) forEach(visit func(m *LiveMarket)) {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	for _, m := range l.markets {
+		visit(m)
+	}
+}
+
+func (l *LiveMarkets) Len() int {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	return len(l.markets)
+}
+
+/*
+	Drops the markets not updated for maxAge
+*/
+func (l *LiveMarkets) Expire(maxAge time.Duration) {
+	oldest := time.Now().Add(-maxAge).Unix()
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for id, m := range l.markets {
+		if m.Updated < oldest {
+			delete(l.markets, id)
+		}
+	}
+}
+
+func (l *LiveMarkets) Backup(fileName string) bool {
+	l.mtx.RLock()
+	err := writeJSONLines(fileName, func(enc *json.Encoder) error {
+		for _, m := range l.markets {
+			if err := enc.Encode(m); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	l.mtx.RUnlock()
+	if err != nil {
+		log.Printf("Markets backup to %s failed: %v\n", fileName, err)
+		return false
+	}
+	log.Printf("Markets backup to %s succeeded\n", fileName)
+	return true
+}
+
+/*
+	The current markets are kept if the file can not be read to the end
+*/
+func (l *LiveMarkets) Restore(fileName string) bool {
+	markets := make(map[int64]*LiveMarket)
+	_, err := readJSONLines(fileName, func(line []byte) error {
+		var m LiveMarket
+		if err := json.Unmarshal(line, &m); err != nil {
+			log.Printf("Error unmarshaling market: %v\n", err)
+			return err
+		}
+		m.index()
+		markets[m.MarketID] = &m
+		return nil
+	})
+	if err != nil {
+		log.Printf("Markets restore from %s failed: %v\n", fileName, err)
+		return false
+	}
+	l.mtx.Lock()
+	l.markets = markets
+	l.mtx.Unlock()
+	log.Printf("Markets restore from %s succeeded: got %d markets\n", fileName, len(markets))
+	return true
+}
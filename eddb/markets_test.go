@@ -0,0 +1,84 @@
+package eddb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLiveMarketsOverDump(t *testing.T) {
+	info := buildTradeEDDBInfo()
+	(*info.stations)[1].EdMarketId = 101
+	(*info.stations)[2].EdMarketId = 102
+	(*info.stations)[3].EdMarketId = 103
+	info = newEDDBInfo(info.commodities, info.systems, info.stations, info.factions, info.primaryStars)
+	markets := NewLiveMarkets()
+	info.SetLiveMarkets(markets)
+
+	dumpTime := (*info.stations)[1].MarketUpdated
+	fresh := time.Unix(dumpTime+60, 0)
+	// Alpha Port raised the gold price, Beta Dock started to sell it
+	markets.OnMarket(nil, &CommodityMessage{MarketID: 101, Timestamp: fresh,
+		Commodities: []CommodityPrice{{Name: "gold", BuyPrice: 120, Stock: 500}}})
+	markets.OnMarket(nil, &CommodityMessage{MarketID: 102, Timestamp: fresh,
+		Commodities: []CommodityPrice{{Name: "gold", BuyPrice: 110, Stock: 10, SellPrice: 100, Demand: 5}, {Name: "silver", SellPrice: 40}}})
+	// older than the dump
+	markets.OnMarket(nil, &CommodityMessage{MarketID: 103, Timestamp: time.Unix(dumpTime-60, 0),
+		Commodities: []CommodityPrice{{Name: "gold", BuyPrice: 1, Stock: 1}}})
+
+	points, err := info.FindCommodity("gold", "Alpha", 1, "L", true, 0, 100, 3600)
+	if err != nil {
+		t.Fatalf("FindCommodity failed: %v", err)
+	}
+	gp := SuitablePoints2galaxy(points)
+	if len(gp) != 2 {
+		t.Fatalf("Expected 2 places, got %+v", gp)
+	}
+	if gp[0].Station != "Alpha Port" || gp[0].BuyPrice != 120 || gp[0].Supply != 500 || gp[0].PriceSource != PriceSourceEDDN {
+		t.Fatalf("Unexpected live price: %+v", gp[0])
+	}
+	if gp[1].Station != "Beta Dock" || gp[1].BuyPrice != 110 || gp[1].PriceSource != PriceSourceEDDN {
+		t.Fatalf("Unexpected live price: %+v", gp[1])
+	}
+
+	// the dump is kept for the stale live market
+	points, err = info.FindSellPlaces("gold", "Alpha", 10, "L", true, 0, 100, 3600)
+	if err != nil {
+		t.Fatalf("FindSellPlaces failed: %v", err)
+	}
+	gp = SuitablePoints2galaxy(points)
+	if len(gp) != 2 || gp[0].Station != "Gamma Hub" || gp[0].PriceSource != PriceSourceEDDB || gp[1].SellPrice != 100 {
+		t.Fatalf("Unexpected sell places: %+v", gp)
+	}
+
+	// Alpha Port does not buy silver any more, Beta Dock has no demand
+	points, err = info.FindSellPlaces("silver", "Beta", 10, "L", true, 0, 100, 3600)
+	if err != nil {
+		t.Fatalf("FindSellPlaces failed: %v", err)
+	}
+	if len(points) != 0 {
+		t.Fatalf("Unexpected silver places: %d", len(points))
+	}
+
+	restored := NewLiveMarkets()
+	testRoundTrip(t, markets.Backup, restored.Restore)
+	if restored.Len() != 3 {
+		t.Fatalf("Expected 3 restored markets, got %d", restored.Len())
+	}
+	if m, _ := restored.Get(102); m == nil {
+		t.Fatalf("Market 102 is lost")
+	} else if p, listed := m.Price("Gold"); !listed || p.BuyPrice != 110 {
+		t.Fatalf("Unexpected restored price: %+v", p)
+	}
+}
+
+func TestNormalizeCommodityName(t *testing.T) {
+	for name, symbol := range map[string]string{
+		"Low Temperature Diamonds": "lowtemperaturediamond",
+		"Agri-Medicines":           "agriculturalmedicines",
+		"Gold":                     "gold",
+	} {
+		if normalizeCommodityName(name) != normalizeCommodityName(symbol) {
+			t.Errorf("%s and %s are expected to match", name, symbol)
+		}
+	}
+}
@@ -1,14 +1,10 @@
 package eddb
 
 import (
-	"bufio"
-	"bytes"
-	"compress/zlib"
-	"context"
 	"encoding/json"
 	"errors"
-	"github.com/go-zeromq/zmq4"
 	"goed/edGalaxy"
+	"goed/metrics"
 	"io"
 	"log"
 	"os"
@@ -20,13 +16,31 @@ import (
 
 const (
 	SCHEMA_KEY = "$schemaRef"
-	RELAY      = "tcp://eddn.edcd.io:9500"
+	RELAY      = "tcp://eddn.edcd.io:9500" // the default one
 
 	cmd_exit            = 0
 	cmd_backup          = 1
 	cmd_restore         = 2
-	cmd_getSystemStat   = 10
-	cmd_getActivityStat = 11
+	cmd_sync            = 3
+	cmd_openStore       = 4
+	cmd_flush           = 5
+
+	rollUpPeriod          = 10 * time.Minute
+	defaultActivityWindow = 7 * 24 * 3600
+	defaultStationWindow  = 24 * 3600
+	maxStationFrames      = 48
+
+	trendingTimeframe      = 3600
+	defaultTrendingWindow  = 3 * 3600
+	trendingBaselineFrames = 7 * 24
+	minTrendingVisits      = 5
+	minTrendingScore       = 3.0
+)
+
+var (
+	eddnReceived = metrics.NewCounter("eddn_messages_received_total", "The EDDN messages read from the source")
+	eddnDropped  = metrics.NewCounterVec("eddn_messages_dropped_total",
+		"The live EDDN messages the busy collector channels did not take", "channel")
 )
 
 type EDDNMessage struct {
@@ -40,36 +54,37 @@ type EDDNMessage struct {
 	Message json.RawMessage `json:"message"`
 }
 
+type FactionStateTrend struct {
+	State string `json:"State"`
+	Trend int    `json:"Trend"`
+}
+
+type FSDJumpFaction struct {
+	Allegiance       string              `json:"Allegiance"`
+	FactionState     string              `json:"FactionState"`
+	Government       string              `json:"Government"`
+	Influence        float64             `json:"Influence"`
+	Name             string              `json:"Name"`
+	PendingStates    []FactionStateTrend `json:"PendingStates,omitempty"`
+	RecoveringStates []FactionStateTrend `json:"RecoveringStates,omitempty"`
+}
+
 type FSDJumpMessage struct {
-	Factions []struct {
-		Allegiance    string  `json:"Allegiance"`
-		FactionState  string  `json:"FactionState"`
-		Government    string  `json:"Government"`
-		Influence     float64 `json:"Influence"`
-		Name          string  `json:"Name"`
-		PendingStates []struct {
-			State string `json:"State"`
-			Trend int    `json:"Trend"`
-		} `json:"PendingStates,omitempty"`
-		RecoveringStates []struct {
-			State string `json:"State"`
-			Trend int    `json:"Trend"`
-		} `json:"RecoveringStates,omitempty"`
-	} `json:"Factions,omitempty"`
-	Population          int64     `json:"Population"`
-	PowerplayState      string    `json:"PowerplayState,omitempty"`
-	Powers              []string  `json:"Powers,omitempty"`
-	StarPos             []float64 `json:"StarPos"`
-	StarSystem          string    `json:"StarSystem"`
-	SystemAddress       int64     `json:"SystemAddress"`
-	SystemAllegiance    string    `json:"SystemAllegiance"`
-	SystemEconomy       string    `json:"SystemEconomy"`
-	SystemFaction       string    `json:"SystemFaction"`
-	SystemGovernment    string    `json:"SystemGovernment"`
-	SystemSecondEconomy string    `json:"SystemSecondEconomy"`
-	SystemSecurity      string    `json:"SystemSecurity"`
-	Event               string    `json:"event"`
-	Timestamp           time.Time `json:"timestamp"`
+	Factions            []FSDJumpFaction `json:"Factions,omitempty"`
+	Population          int64            `json:"Population"`
+	PowerplayState      string           `json:"PowerplayState,omitempty"`
+	Powers              []string         `json:"Powers,omitempty"`
+	StarPos             []float64        `json:"StarPos"`
+	StarSystem          string           `json:"StarSystem"`
+	SystemAddress       int64            `json:"SystemAddress"`
+	SystemAllegiance    string           `json:"SystemAllegiance"`
+	SystemEconomy       string           `json:"SystemEconomy"`
+	SystemFaction       string           `json:"SystemFaction"`
+	SystemGovernment    string           `json:"SystemGovernment"`
+	SystemSecondEconomy string           `json:"SystemSecondEconomy"`
+	SystemSecurity      string           `json:"SystemSecurity"`
+	Event               string           `json:"event"`
+	Timestamp           time.Time        `json:"timestamp"`
 }
 
 type DockedMessage struct {
@@ -101,31 +116,45 @@ type shipStatCollector_controlMessage struct {
 	result  chan interface{}
 }
 
-type shipStatCollector_getSystemVisitStatRequest struct {
-	coords      *edGalaxy.Point3D
-	maxDistance float64
+type shipStatCollector_openStoreRequest struct {
+	fileName     string
+	legacyBackup string
+}
+
+type SystemShipStat struct {
+	Name          string                                        `json:"Name"`
+	Coords        edGalaxy.Point3D                              `json:"Coords"`
+	SystemVisits  *edGalaxy.TieredVisitStatCollector            `json:"systems_visits"`
+	StationVisits map[string]*edGalaxy.TieredVisitStatCollector `json:"stations_visits"`
+	StationNames  map[string]string                             `json:"stations_names,omitempty"` // as reported, by the StationVisits key
 }
 
-type shipStatCollector_getActivityStatRequest struct {
-	coords      *edGalaxy.Point3D
-	maxDistance float64
+func (sss *SystemShipStat) stationName(key string) string {
+	if nm, exists := sss.StationNames[key]; exists {
+		return nm
+	}
+	return key
 }
 
-type shipStatCollector_getSystemVisitStatReply struct {
-	stat         []*edGalaxy.SystemVisitsStat
-	inRangeCount int64
+/*
+	Gets the system state of every parsed FSDJump, CarrierJump and Location,
+	jump.Event tells which one. Called from the collector goroutine.
+*/
+type FSDJumpListener interface {
+	OnFSDJump(m *EDDNMessage, jump *FSDJumpMessage)
 }
 
-type SystemShipStat struct {
-	Name          string                                      `json:"Name"`
-	Coords        edGalaxy.Point3D                            `json:"Coords"`
-	SystemVisits  *edGalaxy.TimeVisitStatCollector            `json:"systems_visits"`
-	StationVisits map[string]*edGalaxy.TimeVisitStatCollector `json:"stations_visits"`
+/*
+	Gets every parsed Docked, called from the collector goroutine
+*/
+type DockedListener interface {
+	OnDocked(m *EDDNMessage, docked *DockedMessage)
 }
 
 type ShipStatCollector struct {
 	fsdJump chan *EDDNMessage
 	docked  chan *EDDNMessage
+	journal chan *eddnEvent // the other events handled
 	control chan shipStatCollector_controlMessage
 	/*
 		0 - idle
@@ -134,29 +163,69 @@ type ShipStatCollector struct {
 	*/
 	listenLoopStatus int32
 
-	timeframe   int64
-	historySize int
+	tiers []edGalaxy.VisitStatTier
+
+	systemsStat *systemStatMap
+	store       *statStore
+	dirty       map[string]bool // the systems changed since the last flush to the store
 
-	systemsStat map[string]*SystemShipStat
+	dropped uint64 // the messages the busy channels did not take
+
+	fsdJumpListeners     []FSDJumpListener
+	dockedListeners      []DockedListener
+	messageListeners     []EDDNMessageListener
+	scanListeners        []ScanListener
+	carrierJumpListeners []CarrierJumpListener
+	marketListeners      []MarketListener
+
+	source EDDNSource
 }
 
 func NewShipStatCollector() *ShipStatCollector {
 	c := &ShipStatCollector{
 		fsdJump:          make(chan *EDDNMessage, 10),
 		docked:           make(chan *EDDNMessage, 10),
+		journal:          make(chan *eddnEvent, 50),
 		control:          make(chan shipStatCollector_controlMessage, 10),
 		listenLoopStatus: 0,
-		timeframe:        3600,
-		historySize:      7 * 24,
-		systemsStat:      make(map[string]*SystemShipStat)}
+		tiers:            edGalaxy.DefaultVisitStatTiers,
+		systemsStat:      newSystemStatMap(nil),
+		dirty:            make(map[string]bool)}
 	go c.processMessages()
 	return c
 }
 
+/*
+	The tiers are to be set before the stat is restored, the finest first.
+	Every tier timeframe is to be a multiple of the previous one.
+*/
+func (c *ShipStatCollector) SetVisitStatTiers(tiers []edGalaxy.VisitStatTier) {
+	if len(tiers) > 0 {
+		c.tiers = tiers
+	}
+}
+
+/*
+	The listeners are to be added before StartListen
+*/
+func (c *ShipStatCollector) AddFSDJumpListener(l FSDJumpListener) {
+	c.fsdJumpListeners = append(c.fsdJumpListeners, l)
+}
+
+func (c *ShipStatCollector) AddDockedListener(l DockedListener) {
+	c.dockedListeners = append(c.dockedListeners, l)
+}
+
+func (c *ShipStatCollector) AddMessageListener(l EDDNMessageListener) {
+	c.messageListeners = append(c.messageListeners, l)
+}
+
 func (c *ShipStatCollector) NoteFSDJump(m *EDDNMessage) error {
 	select {
 	case c.fsdJump <- m:
 	default:
+		atomic.AddUint64(&c.dropped, 1)
+		eddnDropped.With("fsd").Inc()
 		return errors.New("FSD channel is busy")
 	}
 	return nil
@@ -166,14 +235,29 @@ func (c *ShipStatCollector) NoteDocked(m *EDDNMessage) error {
 	select {
 	case c.docked <- m:
 	default:
+		atomic.AddUint64(&c.dropped, 1)
+		eddnDropped.With("docked").Inc()
 		return errors.New("Docked channel is busy")
 	}
 	return nil
 }
 
+/*
+	The live messages dropped since the start as the collector did not keep up
+*/
+func (c *ShipStatCollector) DroppedMessages() uint64 {
+	return atomic.LoadUint64(&c.dropped)
+}
+
 func (c *ShipStatCollector) processMessages() {
+	rollUp := time.NewTicker(rollUpPeriod)
+	defer rollUp.Stop()
 	for {
 		select {
+		case <-rollUp.C:
+			{
+				c.rollUp(time.Now())
+			}
 		case m := <-c.fsdJump:
 			{
 				c.handleFSDJump(m)
@@ -182,8 +266,14 @@ func (c *ShipStatCollector) processMessages() {
 			{
 				c.handleDocked(m)
 			}
+		case e := <-c.journal:
+			{
+				c.handleJournalEvent(e)
+			}
 		case cm := <-c.control:
 			{
+				// the answers cover every message received before the request
+				c.drainMessages()
 				if finish := c.handleControlMessage(&cm); finish {
 					log.Println("ShipStatCollector::processMessages: exiting")
 					return
@@ -193,10 +283,30 @@ func (c *ShipStatCollector) processMessages() {
 	}
 }
 
+func (c *ShipStatCollector) drainMessages() {
+	for {
+		select {
+		case m := <-c.fsdJump:
+			c.handleFSDJump(m)
+		case m := <-c.docked:
+			c.handleDocked(m)
+		case e := <-c.journal:
+			c.handleJournalEvent(e)
+		default:
+			return
+		}
+	}
+}
+
 func (c *ShipStatCollector) handleControlMessage(m *shipStatCollector_controlMessage) bool {
 	switch m.command {
 	case cmd_exit:
 		{
+			if c.store != nil {
+				c.performFlush()
+				c.store.close()
+				c.store = nil
+			}
 			m.result <- 0
 			return true
 		}
@@ -212,14 +322,20 @@ func (c *ShipStatCollector) handleControlMessage(m *shipStatCollector_controlMes
 			m.result <- errcode
 			return false
 		}
-	case cmd_getSystemStat:
+	case cmd_sync:
+		{
+			m.result <- 0
+			return false
+		}
+	case cmd_openStore:
 		{
-			m.result <- c.getSystemVisitStat(m.params.(shipStatCollector_getSystemVisitStatRequest))
+			rq := m.params.(shipStatCollector_openStoreRequest)
+			m.result <- c.performOpenStore(rq.fileName, rq.legacyBackup)
 			return false
 		}
-	case cmd_getActivityStat:
+	case cmd_flush:
 		{
-			m.result <- c.getActivityStat(m.params.(shipStatCollector_getActivityStatRequest))
+			m.result <- c.performFlush()
 			return false
 		}
 	default:
@@ -231,101 +347,367 @@ func (c *ShipStatCollector) handleControlMessage(m *shipStatCollector_controlMes
 	return false
 }
 
-func (c *ShipStatCollector) getActivityStat(rq shipStatCollector_getActivityStatRequest) []*edGalaxy.ActivityStatItem {
-	rv := make([]*edGalaxy.ActivityStatItem, c.historySize)
+/*
+	The jumps and the docks for the last window seconds (a week if 0), the newest first.
+	The frame is the one of the finest tier keeping the window.
+*/
+func (c *ShipStatCollector) GetActivityStat(coords *edGalaxy.Point3D, maxDistance float64, window int64) []*edGalaxy.ActivityStatItem {
+	if window <= 0 {
+		window = defaultActivityWindow
+	}
+	tier := edGalaxy.PickVisitStatTier(c.tiers, window)
+	frames := int((window + tier.Timeframe - 1) / tier.Timeframe)
+	if frames > tier.MaxMarks {
+		frames = tier.MaxMarks
+	}
+	rv := make([]*edGalaxy.ActivityStatItem, frames)
 	statByMark := make(map[int64]*edGalaxy.ActivityStatItem)
 
-	ctf := time.Now().Unix() / c.timeframe
-	for i := 0; i < c.historySize; i++ {
-		item := &edGalaxy.ActivityStatItem{Timestamp: ctf * c.timeframe, NumJumps: 0, NumDocks: 0}
+	ctf := time.Now().Unix() / tier.Timeframe
+	for i := 0; i < frames; i++ {
+		item := &edGalaxy.ActivityStatItem{Timestamp: ctf * tier.Timeframe, NumJumps: 0, NumDocks: 0}
 		rv[i] = item
 		statByMark[ctf] = item
 		ctf--
 	}
+	since := (ctf + 1) * tier.Timeframe
 
-	for _, systemStat := range c.systemsStat {
-		if rq.coords.Distance(&systemStat.Coords) < rq.maxDistance {
-			systemStat.updateActivityByMark(&statByMark)
+	c.systemsStat.forEach(func(_ string, systemStat *SystemShipStat) {
+		if coords.Distance(&systemStat.Coords) < maxDistance {
+			systemStat.updateActivityByMark(statByMark, tier.Timeframe, since)
 		}
-	}
+	})
 	return rv
 }
 
-func (sss *SystemShipStat) updateActivityByMark(statByMark *map[int64]*edGalaxy.ActivityStatItem) {
-	for _, markStat := range sss.SystemVisits.Visits {
-		if frameStat, exists := (*statByMark)[markStat.Timemark]; exists {
-			frameStat.NumJumps += markStat.VisitCount
+func (sss *SystemShipStat) updateActivityByMark(statByMark map[int64]*edGalaxy.ActivityStatItem, frame int64, since int64) {
+	sss.SystemVisits.VisitsByFrame(frame, since, func(mark int64, count int64) {
+		if frameStat, exists := statByMark[mark]; exists {
+			frameStat.NumJumps += count
 		}
+	})
+	for _, stationStat := range sss.StationVisits {
+		stationStat.VisitsByFrame(frame, since, func(mark int64, count int64) {
+			if frameStat, exists := statByMark[mark]; exists {
+				frameStat.NumDocks += count
+			}
+		})
+	}
+}
+
+/*
+	The busiest stations for the last window seconds (a day if 0), the docks per frame the newest first.
+	The frame is the finest one taking maxStationFrames at most. limit <= 0 - no limit.
+*/
+func (c *ShipStatCollector) GetStationActivity(coords *edGalaxy.Point3D, maxDistance float64, window int64, limit int) []*edGalaxy.StationActivity {
+	if window <= 0 {
+		window = defaultStationWindow
 	}
-	for _, StationStat := range sss.StationVisits {
-		for _, markStat := range (*StationStat).Visits {
-			if frameStat, exists := (*statByMark)[markStat.Timemark]; exists {
-				frameStat.NumDocks += markStat.VisitCount
+	tier := edGalaxy.PickVisitStatTier(c.tiers, window)
+	for _, t := range c.tiers {
+		if t.Timeframe > tier.Timeframe && (window+tier.Timeframe-1)/tier.Timeframe > maxStationFrames {
+			tier = t
+		}
+	}
+	frames := (window + tier.Timeframe - 1) / tier.Timeframe
+	if frames > maxStationFrames {
+		frames = maxStationFrames
+	}
+	ctf := time.Now().Unix() / tier.Timeframe
+	since := (ctf - frames + 1) * tier.Timeframe
+
+	stat := make([]*edGalaxy.StationActivity, 0)
+	c.systemsStat.forEach(func(_ string, st *SystemShipStat) {
+		if len(st.StationVisits) == 0 {
+			return
+		}
+		distance := coords.Distance(&st.Coords)
+		if distance > maxDistance {
+			return
+		}
+		for key, visits := range st.StationVisits {
+			if visits.CountSince(since) == 0 {
+				continue
+			}
+			sa := &edGalaxy.StationActivity{
+				Name:       st.stationName(key),
+				SystemName: st.Name,
+				Distance:   distance,
+				Docks:      make([]*edGalaxy.ActivityStatItem, frames)}
+			for i := range sa.Docks {
+				sa.Docks[i] = &edGalaxy.ActivityStatItem{Timestamp: (ctf - int64(i)) * tier.Timeframe}
 			}
+			visits.VisitsByFrame(tier.Timeframe, since, func(mark int64, count int64) {
+				if i := ctf - mark; i >= 0 && i < frames {
+					sa.Docks[i].NumDocks += count
+					sa.NumDocks += count
+				}
+			})
+			if sa.NumDocks > 0 {
+				stat = append(stat, sa)
+			}
+		}
+	})
+
+	sort.Slice(stat, func(i, j int) bool {
+		if stat[i].NumDocks != stat[j].NumDocks {
+			return stat[i].NumDocks > stat[j].NumDocks
+		}
+		return stat[i].Distance < stat[j].Distance
+	})
+	if limit > 0 && len(stat) > limit {
+		stat = stat[:limit]
+	}
+	return stat
+}
+
+/*
+	The systems visited far above their usual for the last window seconds (3 hours if 0),
+	the largest deviation first. A system trends if it is visited at least minTrendingVisits
+	times for the window and minTrendingScore deviations above its week before it.
+	limit <= 0 - no limit.
+*/
+func (c *ShipStatCollector) GetTrendingSystems(coords *edGalaxy.Point3D, maxDistance float64, window int64, limit int) []*edGalaxy.TrendingSystem {
+	if window <= 0 {
+		window = defaultTrendingWindow
+	}
+	frames := (window + trendingTimeframe - 1) / trendingTimeframe
+	now := time.Now().Unix()
+	since := (now/trendingTimeframe - frames + 1) * trendingTimeframe
+
+	stat := make([]*edGalaxy.TrendingSystem, 0)
+	c.systemsStat.forEach(func(_ string, st *SystemShipStat) {
+		distance := coords.Distance(&st.Coords)
+		if distance > maxDistance {
+			return
 		}
+		if st.SystemVisits.CountSince(since) < minTrendingVisits {
+			return
+		}
+		surge := st.SystemVisits.Surge(trendingTimeframe, now, frames, trendingBaselineFrames)
+		if surge.Recent < minTrendingVisits || surge.Score < minTrendingScore {
+			return
+		}
+		stat = append(stat, &edGalaxy.TrendingSystem{
+			Name:         st.Name,
+			Distance:     distance,
+			Visits:       surge.Recent,
+			Rate:         surge.RecentRate,
+			BaselineRate: surge.BaselineRate,
+			Score:        surge.Score})
+	})
+
+	sort.Slice(stat, func(i, j int) bool {
+		if stat[i].Score != stat[j].Score {
+			return stat[i].Score > stat[j].Score
+		}
+		return stat[i].Distance < stat[j].Distance
+	})
+	if limit > 0 && len(stat) > limit {
+		stat = stat[:limit]
 	}
+	return stat
 }
 
-func (c *ShipStatCollector) getSystemVisitStat(rq shipStatCollector_getSystemVisitStatRequest) shipStatCollector_getSystemVisitStatReply {
+/*
+	The visits for the last window seconds, 0 - every visit kept
+*/
+func (c *ShipStatCollector) GetSystemVisitsStat(coords *edGalaxy.Point3D, maxDistance float64, window int64, limit int) ([]*edGalaxy.SystemVisitsStat, int64, error) {
+	var since int64 = 0
+	if window > 0 {
+		since = time.Now().Unix() - window
+	}
 	var totalCount int64 = 0
 	stat := make([]*edGalaxy.SystemVisitsStat, 0)
-	for _, st := range c.systemsStat {
-		if rq.coords.Distance(&st.Coords) <= rq.maxDistance {
-			var systemCount int64 = 0
-			for _, timeVisits := range st.SystemVisits.Visits {
-				systemCount += timeVisits.VisitCount
+	c.systemsStat.forEach(func(_ string, st *SystemShipStat) {
+		if coords.Distance(&st.Coords) <= maxDistance {
+			systemCount := st.SystemVisits.CountSince(since)
+			if systemCount == 0 && window > 0 {
+				return
 			}
 			totalCount += systemCount
 			stat = append(stat, &edGalaxy.SystemVisitsStat{Name: st.Name, Coords: &st.Coords, Count: systemCount})
 		}
+	})
+
+	sort.Slice(stat, func(i, j int) bool {
+		c1 := stat[i].Count
+		c2 := stat[j].Count
+		if c1 != c2 {
+			return c1 > c2 // reverse
+		}
+		d1 := coords.Distance(stat[i].Coords)
+		d2 := coords.Distance(stat[j].Coords)
+		return d1 < d2
+	})
+	if len(stat) > limit {
+		stat = stat[:limit]
 	}
-	return shipStatCollector_getSystemVisitStatReply{stat: stat, inRangeCount: totalCount}
+	return stat, totalCount, nil
+}
+
+/*
+	Moves the aged visits to the coarser tiers
+*/
+func (c *ShipStatCollector) rollUp(now time.Time) {
+	ts := now.Unix()
+	c.systemsStat.updateEach(func(nm string, st *SystemShipStat) {
+		changed := st.SystemVisits.RollUp(ts)
+		for _, stationStat := range st.StationVisits {
+			if stationStat.RollUp(ts) {
+				changed = true
+			}
+		}
+		if changed {
+			c.noteChanged(nm)
+		}
+	})
+}
+
+/*
+	Moves the restored visits to the current tiers if they differ, returns the systems moved
+*/
+func (c *ShipStatCollector) retier(stats map[string]*SystemShipStat) []string {
+	now := time.Now()
+	moved := make([]string, 0)
+	for key, st := range stats {
+		changed := false
+		if !st.SystemVisits.HasTiers(c.tiers) {
+			st.SystemVisits = st.SystemVisits.Retier(c.tiers, now)
+			changed = true
+		}
+		for nm, stationStat := range st.StationVisits {
+			if !stationStat.HasTiers(c.tiers) {
+				st.StationVisits[nm] = stationStat.Retier(c.tiers, now)
+				changed = true
+			}
+		}
+		if changed {
+			moved = append(moved, key)
+		}
+	}
+	return moved
 }
 
 func (c *ShipStatCollector) performBackup(fileName string) int {
-	/*
-	   TODO: rename to .bak old file
-	*/
-	f, err := os.Create(fileName)
+	err := writeJSONLines(fileName, func(enc *json.Encoder) error {
+		var err error
+		c.systemsStat.forEach(func(_ string, st *SystemShipStat) {
+			if err == nil {
+				err = enc.Encode(st)
+			}
+		})
+		return err
+	})
 	if err != nil {
 		log.Printf("Backup to %s failed: %v\n", fileName, err)
 		return 1
 	}
-	defer f.Close()
-
-	enc := json.NewEncoder(f)
-	for _, st := range c.systemsStat {
-		err = enc.Encode(st)
-		if err != nil {
-			log.Printf("Backup encode to %s failed: %v\n", fileName, err)
-			return 1
-		}
-	}
 	log.Printf("Backup to %s succeeded\n", fileName)
 	return 0
 }
 
+/*
+	The current stat is kept if the file can not be read to the end
+*/
 func (c *ShipStatCollector) performRestore(fileName string) int {
-	f, err := os.Open(fileName)
+	stats := make(map[string]*SystemShipStat, 1000)
+	bad, err := readJSONLines(fileName, func(line []byte) error {
+		var s SystemShipStat
+		if err := json.Unmarshal(line, &s); err != nil || s.SystemVisits == nil {
+			log.Printf("Error unmarshaling stat: %v\n", err)
+			return errors.New("Broken stat")
+		}
+		stats[strings.ToUpper(s.Name)] = &s
+		return nil
+	})
 	if err != nil {
-		log.Printf("Restore from %s failed: %v\n", fileName, err)
+		log.Printf("Restore from %s failed after %d stats: %v\n", fileName, len(stats), err)
 		return 1
 	}
-	defer f.Close()
-
-	c.systemsStat = make(map[string]*SystemShipStat, 1000)
+	if moved := c.retier(stats); len(moved) > 0 {
+		log.Printf("Restore from %s moved %d stats to the current tiers\n", fileName, len(moved))
+	}
+	c.systemsStat.replace(stats)
+	if c.store != nil {
+		for nm := range stats {
+			c.dirty[nm] = true
+		}
+	}
+	if bad > 0 {
+		log.Printf("Restore from %s skipped %d broken stats\n", fileName, bad)
+	}
+	log.Printf("Restore from %s succeeded: got %d stats\n", fileName, len(stats))
+	return 0
+}
 
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		var s SystemShipStat
-		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
-			log.Printf("Error unmarshaling stat: %v\n", err)
-			continue
+/*
+	The stat is read from the store, an empty store is filled from
+	the JSON backup of the previous versions if it is given
+*/
+func (c *ShipStatCollector) performOpenStore(fileName string, legacyBackup string) int {
+	store, err := openStatStore(fileName)
+	if err != nil {
+		log.Printf("Failed to open stat store %s: %v\n", fileName, err)
+		return 1
+	}
+	if store.isEmpty() {
+		c.store = store
+		if len(legacyBackup) == 0 {
+			log.Printf("Stat store %s is empty\n", fileName)
+			return 0
+		}
+		if _, err = os.Stat(legacyBackup); os.IsNotExist(err) {
+			log.Printf("Stat store %s is empty, no %s to migrate\n", fileName, legacyBackup)
+			return 0
 		}
-		c.systemsStat[strings.ToUpper(s.Name)] = &s
+		log.Printf("Migrating %s to the stat store %s\n", legacyBackup, fileName)
+		if c.performRestore(legacyBackup) != 0 || c.performFlush() != 0 {
+			return 1
+		}
+		store.setMeta("migrated_from", legacyBackup)
+		return 0
+	}
+	stats, bad, err := store.load()
+	if err != nil {
+		log.Printf("Failed to read stat store %s: %v\n", fileName, err)
+		store.close()
+		return 1
+	}
+	if bad > 0 {
+		log.Printf("Stat store %s has %d broken records\n", fileName, bad)
 	}
+	c.store = store
+	c.systemsStat.replace(stats)
+	c.dirty = make(map[string]bool)
+	for _, nm := range c.retier(stats) {
+		c.dirty[nm] = true
+	}
+	log.Printf("Stat store %s opened: got %d stats\n", fileName, len(stats))
+	return 0
+}
 
-	log.Printf("Restore from %s succeeded: got %d stats\n", fileName, len(c.systemsStat))
+/*
+	Writes the systems changed since the previous flush
+*/
+func (c *ShipStatCollector) performFlush() int {
+	if c.store == nil {
+		return 1
+	}
+	if len(c.dirty) == 0 {
+		return 0
+	}
+	changed := make(map[string]*SystemShipStat, len(c.dirty))
+	for nm := range c.dirty {
+		if st, exists := c.systemsStat.get(nm); exists {
+			changed[nm] = st
+		}
+	}
+	if err := c.store.put(changed); err != nil {
+		log.Printf("Stat store flush of %d systems failed: %v\n", len(changed), err)
+		return 1
+	}
+	c.dirty = make(map[string]bool)
 	return 0
 }
 
@@ -336,30 +718,50 @@ func (c *ShipStatCollector) handleDocked(m *EDDNMessage) {
 		log.Printf("json Docked message failed:\n%s\n", string(m.Message))
 		return
 	}
+	for _, l := range c.dockedListeners {
+		l.OnDocked(m, &docked)
+	}
 	nm := strings.ToUpper(docked.StarSystem)
-	systemStat, exists := c.systemsStat[nm]
-	if !exists {
-		if len(docked.StarPos) != 3 {
-			log.Printf("Docked at %s to %s                          ---- %s %s %s ignoder - strange coords\n", docked.StarSystem, docked.StationName, m.Header.SoftwareName, m.Header.UploaderID, docked.Timestamp.Format(time.StampMilli))
+	noted := false
+	c.systemsStat.update(nm, func(systemStat *SystemShipStat) *SystemShipStat {
+		if systemStat == nil {
+			if len(docked.StarPos) != 3 {
+				log.Printf("Docked at %s to %s                          ---- %s %s %s ignoder - strange coords\n", docked.StarSystem, docked.StationName, m.Header.SoftwareName, m.Header.UploaderID, docked.Timestamp.Format(time.StampMilli))
+				return nil
+			}
+			systemStat = &SystemShipStat{Name: docked.StarSystem,
+				Coords:       edGalaxy.Point3D{X: docked.StarPos[0], Y: docked.StarPos[1], Z: docked.StarPos[2]},
+				SystemVisits: edGalaxy.NewTieredVisitStatCollector(c.tiers),
+			}
+			systemStat.SystemVisits.NoteVisit(docked.Timestamp) // it's me here
+		}
+
+		if systemStat.StationVisits == nil {
+			systemStat.StationVisits = make(map[string]*edGalaxy.TieredVisitStatCollector)
+		}
+		station := strings.ToUpper(docked.StationName)
+		collector, exists := systemStat.StationVisits[station]
+		if !exists {
+			collector = edGalaxy.NewTieredVisitStatCollector(c.tiers)
+			systemStat.StationVisits[station] = collector
 		}
-		systemStat = &SystemShipStat{Name: docked.StarSystem,
-			Coords:       edGalaxy.Point3D{X: docked.StarPos[0], Y: docked.StarPos[1], Z: docked.StarPos[2]},
-			SystemVisits: edGalaxy.NewTimeVisitStatCollector(c.historySize, c.timeframe),
+		if systemStat.StationNames == nil {
+			systemStat.StationNames = make(map[string]string)
 		}
-		c.systemsStat[nm] = systemStat
-		systemStat.SystemVisits.NoteVisit(docked.Timestamp) // it's me here
+		systemStat.StationNames[station] = docked.StationName
+		collector.NoteVisit(docked.Timestamp)
+		noted = true
+		return systemStat
+	})
+	if noted {
+		c.noteChanged(nm)
 	}
+}
 
-	if systemStat.StationVisits == nil {
-		systemStat.StationVisits = make(map[string]*edGalaxy.TimeVisitStatCollector)
-	}
-	nm = strings.ToUpper(docked.StationName)
-	collector, exists := systemStat.StationVisits[nm]
-	if !exists {
-		collector = edGalaxy.NewTimeVisitStatCollector(c.historySize, c.timeframe)
-		systemStat.StationVisits[nm] = collector
+func (c *ShipStatCollector) noteChanged(nm string) {
+	if c.store != nil {
+		c.dirty[nm] = true
 	}
-	collector.NoteVisit(docked.Timestamp)
 }
 
 func (c *ShipStatCollector) handleFSDJump(m *EDDNMessage) {
@@ -369,19 +771,36 @@ func (c *ShipStatCollector) handleFSDJump(m *EDDNMessage) {
 		log.Printf("json FSDJump message failed:\n%s\n", string(m.Message))
 		return
 	}
+	c.noteSystemState(m, &jump, true)
+}
+
+/*
+	The system state of FSDJump, CarrierJump or Location goes to the listeners,
+	the arrivals are counted as the visits
+*/
+func (c *ShipStatCollector) noteSystemState(m *EDDNMessage, jump *FSDJumpMessage, arrival bool) {
 	if len(jump.StarPos) != 3 {
-		log.Printf("FSDJump to %s              ---- %s %s %s\n ignored - strange coordinates", jump.StarSystem, m.Header.SoftwareName, m.Header.UploaderID, jump.Timestamp.Format(time.StampMilli))
+		log.Printf("%s to %s              ---- %s %s %s\n ignored - strange coordinates", jump.Event, jump.StarSystem, m.Header.SoftwareName, m.Header.UploaderID, jump.Timestamp.Format(time.StampMilli))
+		return
+	}
+	for _, l := range c.fsdJumpListeners {
+		l.OnFSDJump(m, jump)
+	}
+	if !arrival {
+		return
 	}
 	nm := strings.ToUpper(jump.StarSystem)
-	systemStat, exists := c.systemsStat[nm]
-	if !exists {
-		systemStat = &SystemShipStat{Name: jump.StarSystem,
-			Coords:       edGalaxy.Point3D{X: jump.StarPos[0], Y: jump.StarPos[1], Z: jump.StarPos[2]},
-			SystemVisits: edGalaxy.NewTimeVisitStatCollector(7*24, 3600),
+	c.systemsStat.update(nm, func(systemStat *SystemShipStat) *SystemShipStat {
+		if systemStat == nil {
+			systemStat = &SystemShipStat{Name: jump.StarSystem,
+				Coords:       edGalaxy.Point3D{X: jump.StarPos[0], Y: jump.StarPos[1], Z: jump.StarPos[2]},
+				SystemVisits: edGalaxy.NewTieredVisitStatCollector(c.tiers),
+			}
 		}
-		c.systemsStat[nm] = systemStat
-	}
-	systemStat.SystemVisits.NoteVisit(jump.Timestamp)
+		systemStat.SystemVisits.NoteVisit(jump.Timestamp)
+		return systemStat
+	})
+	c.noteChanged(nm)
 }
 
 func (c *ShipStatCollector) Shutdown() {
@@ -393,157 +812,171 @@ func (c *ShipStatCollector) Shutdown() {
 	<-m.result
 }
 
-func (c *ShipStatCollector) Backup(fileName string) bool {
+/*
+	Returns when every message received before the call is processed
+*/
+func (c *ShipStatCollector) sync() {
 	m := shipStatCollector_controlMessage{
-		command: cmd_backup,
-		params:  fileName,
+		command: cmd_sync,
+		result:  make(chan interface{})}
+	c.control <- m
+	<-m.result
+}
+
+/*
+	Keeps the stat in the store, it is loaded from the store at once.
+	An empty store is filled from legacyBackup, the JSON backup
+	Backup writes, if it is not empty. Call Flush to write the changes.
+*/
+func (c *ShipStatCollector) OpenStore(fileName string, legacyBackup string) bool {
+	m := shipStatCollector_controlMessage{
+		command: cmd_openStore,
+		params:  shipStatCollector_openStoreRequest{fileName: fileName, legacyBackup: legacyBackup},
 		result:  make(chan interface{})}
 	c.control <- m
 	res := <-m.result
 	return res == 0
 }
 
-func (c *ShipStatCollector) Restore(fileName string) bool {
+/*
+	Writes the systems changed since the previous flush to the store,
+	Shutdown flushes as well
+*/
+func (c *ShipStatCollector) Flush() bool {
 	m := shipStatCollector_controlMessage{
-		command: cmd_restore,
-		params:  fileName,
+		command: cmd_flush,
 		result:  make(chan interface{})}
 	c.control <- m
 	res := <-m.result
 	return res == 0
 }
 
-func (c *ShipStatCollector) GetSystemVisitsStat(coords *edGalaxy.Point3D, maxDistance float64, limit int) ([]*edGalaxy.SystemVisitsStat, int64, error) {
+func (c *ShipStatCollector) Backup(fileName string) bool {
 	m := shipStatCollector_controlMessage{
-		command: cmd_getSystemStat,
-		params:  shipStatCollector_getSystemVisitStatRequest{coords: coords, maxDistance: maxDistance},
+		command: cmd_backup,
+		params:  fileName,
 		result:  make(chan interface{})}
 	c.control <- m
-	r := <-m.result
-
-	rpl := r.(shipStatCollector_getSystemVisitStatReply)
-
-	sort.Slice(rpl.stat, func(i, j int) bool {
-		c1 := rpl.stat[i].Count
-		c2 := rpl.stat[j].Count
-		if c1 != c2 {
-			return c1 > c2 // reverse
-		}
-		d1 := coords.Distance(rpl.stat[i].Coords)
-		d2 := coords.Distance(rpl.stat[j].Coords)
-		return d1 < d2
-	})
-	if len(rpl.stat) > limit {
-		rpl.stat = rpl.stat[:limit]
-	}
-	return rpl.stat, rpl.inRangeCount, nil
+	res := <-m.result
+	return res == 0
 }
 
-func (c *ShipStatCollector) GetActivityStat(coords *edGalaxy.Point3D, maxDistance float64) []*edGalaxy.ActivityStatItem {
+func (c *ShipStatCollector) Restore(fileName string) bool {
 	m := shipStatCollector_controlMessage{
-		command: cmd_getActivityStat,
-		params:  shipStatCollector_getActivityStatRequest{coords: coords, maxDistance: maxDistance},
+		command: cmd_restore,
+		params:  fileName,
 		result:  make(chan interface{})}
-
 	c.control <- m
-
-	return (<-m.result).([]*edGalaxy.ActivityStatItem)
+	res := <-m.result
+	return res == 0
 }
 
-func (c *ShipStatCollector) listenLoop() {
-	needDeal := true
-	var req zmq4.Socket = nil
+func (c *ShipStatCollector) dispatchMessage(m *EDDNMessage, wait bool) {
+	if strings.Contains(m.SchemaRef, commoditySchema) {
+		c.noteJournalEvent(&eddnEvent{name: eventCommodity, m: m}, wait)
+		return
+	}
 
-	for {
-		if atomic.LoadInt32(&c.listenLoopStatus) == 2 {
-			if req != nil {
-				req.Close()
-				return
-			}
+	var objmap map[string]*json.RawMessage
+	err := json.Unmarshal(m.Message, &objmap)
+	if err != nil {
+		log.Println("json decode message failed")
+		return
+	}
+
+	evt, exists := objmap["event"]
+	if !exists {
+		//			log.Println("event does not exists.")
+		return
+	}
+
+	var evtName string
+	if err = json.Unmarshal(*evt, &evtName); err != nil {
+		log.Printf("Failed to decode event name")
+		return
+	}
+
+	switch evtName {
+	case eventScan, eventLocation, eventCarrierJump:
+		{
+			c.noteJournalEvent(&eddnEvent{name: evtName, m: m}, wait)
 		}
-		if req == nil {
-			req = zmq4.NewSub(context.Background())
-			req.SetOption(zmq4.OptionSubscribe, "")
-			needDeal = true
-		}
-		if needDeal {
-			log.Printf("Dialing %s", RELAY)
-			err := req.Dial(RELAY)
-			if err != nil {
-				log.Printf("could not dial: %v", err)
-				time.Sleep(5 * time.Second)
-				continue
+	case "FSDJump":
+		{
+			if wait {
+				c.fsdJump <- m
+			} else {
+				c.NoteFSDJump(m)
 			}
-			needDeal = false
 		}
-		msg, err := req.Recv()
-		if err != nil {
-			log.Printf("could not recv: %v", err)
-			if req != nil {
-				req.Close()
-				req = nil
+	case "Docked":
+		{
+			if wait {
+				c.docked <- m
+			} else {
+				c.NoteDocked(m)
 			}
-			time.Sleep(5 * time.Second)
-			continue
-		}
-		b := bytes.NewReader(msg.Bytes())
-		r, err := zlib.NewReader(b)
-		if err != nil {
-			log.Printf("decompress failed: %v", err)
-			continue
 		}
+	default:
+		{
+			log.Printf("Evt: %s Ref %s from %s\n", evtName, m.SchemaRef, m.Header.SoftwareName)
 
-		dec := json.NewDecoder(r)
-		var m EDDNMessage
-		if err := dec.Decode(&m); err == io.EOF {
-			log.Println("EOF")
-		} else if err != nil {
-			r.Close()
-			log.Println("json decode failed")
-			continue
 		}
-		r.Close()
+	}
+}
+
+func (c *ShipStatCollector) listenLoop() {
+	src := c.source
+	if src == nil {
+		src = NewRelaySource(RELAY)
+	}
+	defer src.Close()
 
-		var objmap map[string]*json.RawMessage
-		err = json.Unmarshal(m.Message, &objmap)
+	for atomic.LoadInt32(&c.listenLoopStatus) != 2 {
+		m, err := src.Next()
+		if err == io.EOF {
+			log.Println("EDDN source is exhausted")
+			return
+		}
 		if err != nil {
-			log.Println("json decode message failed")
+			log.Printf("EDDN source: %v", err)
 			continue
 		}
-
-		evt, exists := objmap["event"]
-		if !exists {
-			//			log.Println("event does not exists.")
-			continue
+		eddnReceived.Inc()
+		for _, l := range c.messageListeners {
+			l.OnEDDNMessage(m)
 		}
+		c.dispatchMessage(m, !src.IsLive())
+	}
+}
 
-		var evtName string
-		if err = json.Unmarshal(*evt, &evtName); err != nil {
-			log.Printf("Failed to decode event name")
-			continue
+/*
+	Feeds the collector with every message of the source, returns when
+	the source is exhausted and the messages are processed. Not to be mixed with StartListen.
+*/
+func (c *ShipStatCollector) Replay(src EDDNSource) (int, error) {
+	n := 0
+	for {
+		m, err := src.Next()
+		if err == io.EOF {
+			c.sync()
+			return n, nil
 		}
-
-		switch evtName {
-		case "Scan", "Location":
-			{
-			}
-		case "FSDJump":
-			{
-				c.NoteFSDJump(&m)
-			}
-		case "Docked":
-			{
-				c.NoteDocked(&m)
-			}
-		default:
-			{
-				log.Printf("Evt: %s Ref %s from %s\n", evtName, m.SchemaRef, m.Header.SoftwareName)
-
-			}
+		if err != nil {
+			return n, err
 		}
+		c.dispatchMessage(m, true)
+		n++
 	}
 }
 
+/*
+	The source is to be set before StartListen, the live relay is used by default
+*/
+func (c *ShipStatCollector) SetSource(src EDDNSource) {
+	c.source = src
+}
+
 func (c *ShipStatCollector) StartListen() {
 	run := atomic.CompareAndSwapInt32(&c.listenLoopStatus, 0, 1)
 	if run {
@@ -0,0 +1,130 @@
+package eddb
+
+import (
+	"goed/edGalaxy"
+	"testing"
+	"time"
+)
+
+func testJumps(systems ...string) *eddnMessageSource {
+	return testJumpsAt(time.Now(), systems...)
+}
+
+func testJumpsAt(ts time.Time, systems ...string) *eddnMessageSource {
+	now := ts.UTC().Format(time.RFC3339)
+	src := &eddnMessageSource{}
+	for _, s := range systems {
+		src.messages = append(src.messages, &EDDNMessage{
+			SchemaRef: "https://eddn.edcd.io/schemas/journal/1",
+			Message:   []byte(`{"event":"FSDJump","StarSystem":"` + s + `","StarPos":[0,0,0],"timestamp":"` + now + `"}`)})
+	}
+	return src
+}
+
+func testDocksAt(ts time.Time, station string, count int) *eddnMessageSource {
+	src := &eddnMessageSource{}
+	for i := 0; i < count; i++ {
+		src.messages = append(src.messages, &EDDNMessage{
+			SchemaRef: "https://eddn.edcd.io/schemas/journal/1",
+			Message: []byte(`{"event":"Docked","StarSystem":"Shinrarta Dezhra","StarPos":[55.71875,17.59375,27.15625],` +
+				`"StationName":"` + station + `","StationType":"Coriolis","timestamp":"` + ts.UTC().Format(time.RFC3339) + `"}`)})
+	}
+	return src
+}
+
+func TestVisitStatWindow(t *testing.T) {
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	c.Replay(testJumpsAt(time.Now().Add(-3*24*time.Hour), "Sol", "Lave", "Lave"))
+	c.Replay(testJumpsAt(time.Now().Add(-2*time.Hour), "Sol"))
+	c.Replay(testJumps("Sol", "Achenar"))
+
+	stat, total, err := c.GetSystemVisitsStat(edGalaxy.Sol, 1000, 0, 10)
+	if err != nil || total != 6 || len(stat) != 3 {
+		t.Fatalf("Unexpected total stat: %d visits in %d systems, %v", total, len(stat), err)
+	}
+	stat, total, _ = c.GetSystemVisitsStat(edGalaxy.Sol, 1000, 6*3600, 10)
+	if total != 3 || len(stat) != 2 || stat[0].Name != "Sol" || stat[0].Count != 2 {
+		t.Fatalf("Unexpected stat for 6 hours: %d visits in %d systems", total, len(stat))
+	}
+	if _, total, _ = c.GetSystemVisitsStat(edGalaxy.Sol, 1000, 3600, 10); total != 2 {
+		t.Fatalf("Unexpected stat for an hour: %d visits", total)
+	}
+}
+
+func TestStationActivity(t *testing.T) {
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	c.Replay(testDocksAt(time.Now().Add(-3*24*time.Hour), "Jameson Memorial", 5))
+	c.Replay(testDocksAt(time.Now().Add(-2*time.Hour), "Jameson Memorial", 1))
+	c.Replay(testDocksAt(time.Now(), "Jameson Memorial", 1))
+	c.Replay(testDocksAt(time.Now(), "X9Z-12B", 3))
+
+	stat := c.GetStationActivity(edGalaxy.Sol, 100, 0, 10)
+	if len(stat) != 2 || stat[0].Name != "X9Z-12B" || stat[0].NumDocks != 3 || stat[1].NumDocks != 2 {
+		t.Fatalf("Unexpected station activity: %d stations", len(stat))
+	}
+	if stat[1].Name != "Jameson Memorial" || stat[1].SystemName != "Shinrarta Dezhra" {
+		t.Fatalf("Unexpected station: %s in %s", stat[1].Name, stat[1].SystemName)
+	}
+	// a day in hours
+	docks := stat[1].Docks
+	if len(docks) != 24 || docks[0].NumDocks != 1 || docks[0].Timestamp-docks[1].Timestamp != 3600 {
+		t.Fatalf("Unexpected buckets: %d", len(docks))
+	}
+	var sum int64 = 0
+	for _, d := range docks {
+		sum += d.NumDocks
+	}
+	if sum != 2 {
+		t.Fatalf("Unexpected docks in the buckets: %d", sum)
+	}
+
+	if stat = c.GetStationActivity(edGalaxy.Sol, 100, 7*24*3600, 1); len(stat) != 1 || stat[0].NumDocks != 7 || len(stat[0].Docks) != 7 {
+		t.Fatalf("Unexpected station activity for a week")
+	}
+	if stat = c.GetStationActivity(edGalaxy.Sol, 10, 0, 10); len(stat) != 0 {
+		t.Fatalf("Unexpected stations out of range: %d", len(stat))
+	}
+	for _, limit := range []int{0, -1} {
+		if stat = c.GetStationActivity(edGalaxy.Sol, 100, 0, limit); len(stat) != 2 {
+			t.Fatalf("Expected all the stations for limit %d, got %d", limit, len(stat))
+		}
+	}
+}
+
+func TestTrendingSystems(t *testing.T) {
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	for h := 1; h <= 48; h++ {
+		c.Replay(testJumpsAt(time.Now().Add(-time.Duration(h)*time.Hour), "Sol", "Sol"))
+	}
+	c.Replay(testJumps("Sol", "Sol", "Sol"))
+	for i := 0; i < 20; i++ {
+		c.Replay(testJumps("Lave"))
+	}
+
+	trending := c.GetTrendingSystems(edGalaxy.Sol, 1000, 0, 10)
+	if len(trending) != 1 || trending[0].Name != "Lave" || trending[0].Visits != 20 || trending[0].BaselineRate != 0 {
+		t.Fatalf("Expected Lave trending alone, got %d systems", len(trending))
+	}
+	if trending = c.GetTrendingSystems(&edGalaxy.Point3D{X: 100}, 10, 0, 10); len(trending) != 0 {
+		t.Fatalf("Unexpected trending out of range: %d", len(trending))
+	}
+	for _, limit := range []int{0, -1} {
+		if trending = c.GetTrendingSystems(edGalaxy.Sol, 1000, 0, limit); len(trending) != 1 {
+			t.Fatalf("Expected all the trending systems for limit %d, got %d", limit, len(trending))
+		}
+	}
+}
+
+func TestDroppedMessages(t *testing.T) {
+	c := &ShipStatCollector{fsdJump: make(chan *EDDNMessage, 1), docked: make(chan *EDDNMessage, 1)}
+	m := testJumps("Sol").messages[0]
+	if c.NoteFSDJump(m) != nil || c.NoteFSDJump(m) == nil || c.NoteDocked(m) != nil || c.NoteDocked(m) == nil {
+		t.Fatalf("The full channels took the messages")
+	}
+	if c.DroppedMessages() != 2 {
+		t.Fatalf("Expected 2 dropped messages, got %d", c.DroppedMessages())
+	}
+}
@@ -0,0 +1,215 @@
+package eddb
+
+import (
+	"container/heap"
+	"errors"
+	"goed/edGalaxy"
+	"log"
+	"math"
+)
+
+const (
+	maxRouteJumpRange  = 500
+	maxRouteExpansions = 250000
+
+	/*
+		Route cost model: every jump costs jumpCost.
+		A supercharged jump needs a trip to the jet cone
+		and takes some FSD damage, so it is a bit more expensive.
+	*/
+	jumpCost             = 1.0
+	superchargedJumpCost = 1.5
+	neutronBoostFactor   = 4
+)
+
+type routeNode struct {
+	system       *SystemRecordV5
+	coords       *edGalaxy.Point3D
+	star         *edGalaxy.StarInfo
+	jumps        int
+	cost         float64
+	travelled    float64
+	estimate     float64
+	supercharged bool
+	prev         *routeNode
+	closed       bool
+	heapIndex    int
+}
+
+type routeQueue []*routeNode
+
+func (q routeQueue) Len() int { return len(q) }
+
+func (q routeQueue) Less(i, j int) bool {
+	fi := q[i].cost + q[i].estimate
+	fj := q[j].cost + q[j].estimate
+	if fi != fj {
+		return fi < fj
+	}
+	return q[i].travelled < q[j].travelled
+}
+
+func (q routeQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].heapIndex = i
+	q[j].heapIndex = j
+}
+
+func (q *routeQueue) Push(x interface{}) {
+	n := x.(*routeNode)
+	n.heapIndex = len(*q)
+	*q = append(*q, n)
+}
+
+func (q *routeQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	n.heapIndex = -1
+	return n
+}
+
+type routePlanner struct {
+	info        *EDDBInfo
+	jumpRange   float64
+	maxRange    float64
+	opts        edGalaxy.RouteOptions
+	destination *SystemRecordV5
+	target      *edGalaxy.Point3D
+	nodes       map[int]*routeNode
+	queue       *routeQueue
+}
+
+// never overestimates: every jump costs at least jumpCost and covers at most maxRange
+func (p *routePlanner) costEstimate(from *edGalaxy.Point3D) float64 {
+	return math.Ceil(from.Distance(p.target)/p.maxRange) * jumpCost
+}
+
+func (p *routePlanner) newNode(s *SystemRecordV5) *routeNode {
+	n := &routeNode{system: s, coords: s.GetCoordinates(), star: p.info.getPrimaryStar(s.Id), heapIndex: -1}
+	n.estimate = p.costEstimate(n.coords)
+	return n
+}
+
+func (p *routePlanner) canStopAt(s *SystemRecordV5) bool {
+	if !p.opts.ScoopableOnly || s == p.destination {
+		return true
+	}
+	star := p.info.getPrimaryStar(s.Id)
+	return star != nil && star.IsScoopable
+}
+
+func (p *routePlanner) expand(current *routeNode) {
+	reach := p.jumpRange
+	boosted := p.opts.UseNeutrons && current.star.IsNeutronStar()
+	if boosted {
+		reach = p.jumpRange * neutronBoostFactor
+	}
+	p.info.systemsInRange(current.coords, reach, func(s *SystemRecordV5, distance float64) bool {
+		next, known := p.nodes[s.Id]
+		if known && next.closed {
+			return true
+		}
+		if !p.canStopAt(s) {
+			return true
+		}
+		supercharged := boosted && distance > p.jumpRange
+		cost := current.cost + jumpCost
+		if supercharged {
+			cost = current.cost + superchargedJumpCost
+		}
+		travelled := current.travelled + distance
+		if !known {
+			next = p.newNode(s)
+			p.nodes[s.Id] = next
+		} else if next.cost < cost || (next.cost == cost && next.travelled <= travelled) {
+			return true
+		}
+		next.jumps = current.jumps + 1
+		next.cost = cost
+		next.travelled = travelled
+		next.supercharged = supercharged
+		next.prev = current
+		if next.heapIndex < 0 {
+			heap.Push(p.queue, next)
+		} else {
+			heap.Fix(p.queue, next.heapIndex)
+		}
+		return true
+	})
+}
+
+/*
+	FindRoute plans the cheapest route between two systems known to EDDB.
+	Only EDDB systems are used as the waypoints. The cost is mostly
+	the number of jumps, among the routes with equal cost the shortest one wins.
+*/
+func (i *EDDBInfo) FindRoute(from, to string, jumpRange float64, opts *edGalaxy.RouteOptions) (*edGalaxy.Route, error) {
+	if jumpRange <= 0 || jumpRange > maxRouteJumpRange {
+		return nil, errors.New("Jump range is out of limits")
+	}
+	origin, ok := i.GetSystemByName(from)
+	if !ok {
+		return nil, errors.New("Unknown origin system")
+	}
+	destination, ok := i.GetSystemByName(to)
+	if !ok {
+		return nil, errors.New("Unknown destination system")
+	}
+
+	p := &routePlanner{
+		info:        i,
+		jumpRange:   jumpRange,
+		maxRange:    jumpRange,
+		destination: destination,
+		target:      destination.GetCoordinates(),
+		nodes:       make(map[int]*routeNode),
+		queue:       &routeQueue{},
+	}
+	if opts != nil {
+		p.opts = *opts
+	}
+	if p.opts.UseNeutrons {
+		p.maxRange = jumpRange * neutronBoostFactor
+	}
+
+	start := p.newNode(origin)
+	p.nodes[origin.Id] = start
+	heap.Push(p.queue, start)
+
+	expansions := 0
+	for p.queue.Len() > 0 {
+		current := heap.Pop(p.queue).(*routeNode)
+		if current.system == destination {
+			return current.route(), nil
+		}
+		current.closed = true
+		expansions++
+		if expansions > maxRouteExpansions {
+			log.Printf("Route %s -> %s (%.2f LY) gave up after %d systems\n", from, to, jumpRange, expansions)
+			return nil, errors.New("The route is too long to plan")
+		}
+		p.expand(current)
+	}
+	return nil, errors.New("No route found, try a bigger jump range")
+}
+
+func (n *routeNode) route() *edGalaxy.Route {
+	waypoints := make([]*edGalaxy.RouteWaypoint, n.jumps+1)
+	for cur := n; cur != nil; cur = cur.prev {
+		hop := 0.0
+		if cur.prev != nil {
+			hop = cur.coords.Distance(cur.prev.coords)
+		}
+		waypoints[cur.jumps] = &edGalaxy.RouteWaypoint{
+			Name:         cur.system.Name,
+			Coords:       cur.coords,
+			Distance:     hop,
+			PrimaryStar:  cur.star,
+			Supercharged: cur.supercharged}
+	}
+	return &edGalaxy.Route{
+		Waypoints:     waypoints,
+		Jumps:         n.jumps,
+		TotalDistance: n.travelled}
+}
@@ -0,0 +1,97 @@
+package eddb
+
+import (
+	"fmt"
+	"goed/edGalaxy"
+	"testing"
+)
+
+func buildLineEDDBInfo() *EDDBInfo {
+	return buildLineEDDBInfoWithStars(make(map[int]*BodyRecordV5))
+}
+
+func buildLineEDDBInfoWithStars(primaryStars map[int]*BodyRecordV5) *EDDBInfo {
+	systems := make(map[int]*SystemRecordV5)
+	for i := 0; i <= 10; i++ {
+		systems[i+1] = &SystemRecordV5{Id: i + 1, Name: fmt.Sprintf("Line %d", i*10), X: float64(i * 10)}
+	}
+	// a shortcut that is not closer in jumps
+	systems[100] = &SystemRecordV5{Id: 100, Name: "Aside", X: 50, Y: 15}
+	commodities := make(map[int]*CommodityRecordV5)
+	stations := make(map[int]*StationRecordV5)
+	factions := make(map[int]*FactionRecordV5)
+	return newEDDBInfo(&commodities, &systems, &stations, &factions, &primaryStars)
+}
+
+func TestFindRouteLeastJumps(t *testing.T) {
+	info := buildLineEDDBInfo()
+
+	route, err := info.FindRoute("line 0", "LINE 100", 25, nil)
+	if err != nil {
+		t.Fatalf("FindRoute failed: %v", err)
+	}
+	if route.Jumps != 5 || len(route.Waypoints) != 6 {
+		t.Fatalf("Expected 5 jumps, got %d (%d waypoints)", route.Jumps, len(route.Waypoints))
+	}
+	if route.Waypoints[0].Name != "Line 0" || route.Waypoints[5].Name != "Line 100" {
+		t.Fatalf("Unexpected ends: %s - %s", route.Waypoints[0].Name, route.Waypoints[5].Name)
+	}
+	total := 0.0
+	for _, w := range route.Waypoints {
+		if w.Distance > 25 {
+			t.Fatalf("Hop to %s is %.2f LY long", w.Name, w.Distance)
+		}
+		total += w.Distance
+	}
+	if total != route.TotalDistance || total != 100 {
+		t.Fatalf("Total distance mismatch: %.2f vs %.2f", total, route.TotalDistance)
+	}
+
+	if _, err = info.FindRoute("Line 0", "Line 100", 5, nil); err == nil {
+		t.Fatalf("Expected no route with a 5 LY jump range")
+	}
+	if _, err = info.FindRoute("Line 0", "Nowhere", 25, nil); err == nil {
+		t.Fatalf("Expected unknown system error")
+	}
+}
+
+func TestFindRouteScoopableOnly(t *testing.T) {
+	primaryStars := make(map[int]*BodyRecordV5)
+	for i := 1; i <= 11; i++ {
+		primaryStars[i] = &BodyRecordV5{ID: i, SystemID: i, SpectralClass: "G", IsMainStar: true}
+	}
+	primaryStars[5].SpectralClass = "Y" // Line 40
+	info := buildLineEDDBInfoWithStars(primaryStars)
+
+	route, err := info.FindRoute("Line 0", "Line 100", 15, nil)
+	if err != nil || route.Jumps != 10 {
+		t.Fatalf("Expected 10 jumps without options: %v", err)
+	}
+	if _, err = info.FindRoute("Line 0", "Line 100", 15, &edGalaxy.RouteOptions{ScoopableOnly: true}); err == nil {
+		t.Fatalf("Expected no scoopable route through Line 40")
+	}
+	route, err = info.FindRoute("Line 0", "Line 100", 25, &edGalaxy.RouteOptions{ScoopableOnly: true})
+	if err != nil {
+		t.Fatalf("FindRoute failed: %v", err)
+	}
+	for _, w := range route.Waypoints[1 : len(route.Waypoints)-1] {
+		if w.PrimaryStar == nil || !w.PrimaryStar.IsScoopable {
+			t.Fatalf("Stop at %s is not scoopable", w.Name)
+		}
+	}
+}
+
+func TestFindRouteNeutronBoost(t *testing.T) {
+	primaryStars := map[int]*BodyRecordV5{
+		1: &BodyRecordV5{ID: 1, SystemID: 1, TypeName: "Neutron Star", SpectralClass: "N", IsMainStar: true},
+	}
+	info := buildLineEDDBInfoWithStars(primaryStars)
+
+	route, err := info.FindRoute("Line 0", "Line 100", 25, &edGalaxy.RouteOptions{UseNeutrons: true})
+	if err != nil {
+		t.Fatalf("FindRoute failed: %v", err)
+	}
+	if route.Jumps != 1 || !route.Waypoints[1].Supercharged {
+		t.Fatalf("Expected one supercharged jump, got %d jumps", route.Jumps)
+	}
+}
@@ -0,0 +1,112 @@
+package eddb
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+const statShards = 64
+
+type statShard struct {
+	mtx   sync.RWMutex
+	stats map[string]*SystemShipStat
+}
+
+/*
+	The system stat split by the name hash. The collector goroutine is the only writer,
+	it takes the shard lock to change a system. The queries run on the caller goroutines
+	and lock one shard at a time, so a galaxy-wide scan never stalls the intake for long.
+*/
+type systemStatMap struct {
+	shards [statShards]statShard
+}
+
+func newSystemStatMap(stats map[string]*SystemShipStat) *systemStatMap {
+	m := &systemStatMap{}
+	for i := range m.shards {
+		m.shards[i].stats = make(map[string]*SystemShipStat)
+	}
+	for nm, st := range stats {
+		m.shard(nm).stats[nm] = st
+	}
+	return m
+}
+
+func (m *systemStatMap) shard(nm string) *statShard {
+	h := fnv.New32a()
+	h.Write([]byte(nm))
+	return &m.shards[h.Sum32()%statShards]
+}
+
+func (m *systemStatMap) get(nm string) (*SystemShipStat, bool) {
+	sh := m.shard(nm)
+	sh.mtx.RLock()
+	defer sh.mtx.RUnlock()
+	st, exists := sh.stats[nm]
+	return st, exists
+}
+
+/*
+	Runs change under the lock of the shard, st is nil if the system is not known yet.
+	The returned stat is kept, nil keeps nothing new.
+*/
+func (m *systemStatMap) update(nm string, change func(st *SystemShipStat) *SystemShipStat) {
+	sh := m.shard(nm)
+	sh.mtx.Lock()
+	defer sh.mtx.Unlock()
+	if st := change(sh.stats[nm]); st != nil {
+		sh.stats[nm] = st
+	}
+}
+
+/*
+	The stat is not to be changed by visit
+*/
+func (m *systemStatMap) forEach(visit func(nm string, st *SystemShipStat)) {
+	for i := range m.shards {
+		sh := &m.shards[i]
+		sh.mtx.RLock()
+		for nm, st := range sh.stats {
+			visit(nm, st)
+		}
+		sh.mtx.RUnlock()
+	}
+}
+
+/*
+	Same as forEach under the write locks, change may modify the stat
+*/
+func (m *systemStatMap) updateEach(change func(nm string, st *SystemShipStat)) {
+	for i := range m.shards {
+		sh := &m.shards[i]
+		sh.mtx.Lock()
+		for nm, st := range sh.stats {
+			change(nm, st)
+		}
+		sh.mtx.Unlock()
+	}
+}
+
+/*
+	Swaps the whole stat, the readers see either the old or the new one per shard
+*/
+func (m *systemStatMap) replace(stats map[string]*SystemShipStat) {
+	fresh := newSystemStatMap(stats)
+	for i := range m.shards {
+		sh := &m.shards[i]
+		sh.mtx.Lock()
+		sh.stats = fresh.shards[i].stats
+		sh.mtx.Unlock()
+	}
+}
+
+func (m *systemStatMap) count() int {
+	n := 0
+	for i := range m.shards {
+		sh := &m.shards[i]
+		sh.mtx.RLock()
+		n += len(sh.stats)
+		sh.mtx.RUnlock()
+	}
+	return n
+}
@@ -0,0 +1,33 @@
+package eddb
+
+import (
+	"goed/edGalaxy"
+	"testing"
+	"time"
+)
+
+func TestConcurrentQueries(t *testing.T) {
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 200; i++ {
+			c.Replay(testJumps("Sol", "Lave", "Achenar"))
+			c.Replay(testDocksAt(time.Now(), "Jameson Memorial", 1))
+		}
+		close(done)
+	}()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+			c.GetSystemVisitsStat(edGalaxy.Sol, 1000, 0, 10)
+			c.GetActivityStat(edGalaxy.Sol, 1000, 3600)
+			c.GetStationActivity(edGalaxy.Sol, 1000, 0, 10)
+		}
+	}
+	if _, total, _ := c.GetSystemVisitsStat(edGalaxy.Sol, 1000, 0, 10); total != 200*3+1 {
+		t.Fatalf("Unexpected visits: %d", total)
+	}
+}
@@ -0,0 +1,98 @@
+package eddb
+
+import (
+	"encoding/json"
+	bolt "go.etcd.io/bbolt"
+	"log"
+	"time"
+)
+
+var (
+	statBucket = []byte("systems")
+	metaBucket = []byte("meta")
+)
+
+/*
+	The visit statistics on disk, one record per system by the upper case name.
+	The collector writes only the systems changed since the previous flush,
+	every flush is one transaction, so a crash loses the last period at most.
+*/
+type statStore struct {
+	db *bolt.DB
+}
+
+func openStatStore(fileName string) (*statStore, error) {
+	db, err := bolt.Open(fileName, 0644, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(statBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(metaBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &statStore{db: db}, nil
+}
+
+func (s *statStore) close() error {
+	return s.db.Close()
+}
+
+func (s *statStore) isEmpty() bool {
+	empty := true
+	s.db.View(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(statBucket).Cursor().First()
+		empty = k == nil
+		return nil
+	})
+	return empty
+}
+
+/*
+	The broken records are logged and skipped, their count is returned
+*/
+func (s *statStore) load() (map[string]*SystemShipStat, int, error) {
+	stats := make(map[string]*SystemShipStat)
+	bad := 0
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(statBucket).ForEach(func(k, v []byte) error {
+			var st SystemShipStat
+			if err := json.Unmarshal(v, &st); err != nil || st.SystemVisits == nil {
+				log.Printf("Broken stat record %s: %v\n", string(k), err)
+				bad++
+				return nil
+			}
+			stats[string(k)] = &st
+			return nil
+		})
+	})
+	return stats, bad, err
+}
+
+func (s *statStore) put(stats map[string]*SystemShipStat) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(statBucket)
+		for nm, st := range stats {
+			data, err := json.Marshal(st)
+			if err != nil {
+				return err
+			}
+			if err = b.Put([]byte(nm), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *statStore) setMeta(key, value string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(metaBucket).Put([]byte(key), []byte(value))
+	})
+}
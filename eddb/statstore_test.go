@@ -0,0 +1,94 @@
+package eddb
+
+import (
+	"goed/edGalaxy"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func visitCounts(c *ShipStatCollector) map[string]int64 {
+	stat, _, _ := c.GetSystemVisitsStat(&edGalaxy.Point3D{}, 1, 0, 10)
+	counts := make(map[string]int64)
+	for _, s := range stat {
+		counts[s.Name] = s.Count
+	}
+	return counts
+}
+
+func TestStatStoreMigration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "statstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	legacy := filepath.Join(dir, "stat.json")
+	storeFile := filepath.Join(dir, "stat.db")
+
+	c := NewShipStatCollector()
+	c.Replay(testJumps("Sol", "Sol", "Lave"))
+	if !c.Backup(legacy) {
+		t.Fatalf("Backup failed")
+	}
+	c.Shutdown()
+	if _, err = os.Stat(legacy + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("The temporary backup is left")
+	}
+
+	c = NewShipStatCollector()
+	if !c.OpenStore(storeFile, legacy) {
+		t.Fatalf("OpenStore failed")
+	}
+	if counts := visitCounts(c); counts["Sol"] != 2 || counts["Lave"] != 1 {
+		t.Fatalf("Unexpected migrated counts: %v", counts)
+	}
+	c.Replay(testJumps("Lave", "Achenar"))
+	if !c.Flush() {
+		t.Fatalf("Flush failed")
+	}
+	c.Replay(testJumps("Achenar"))
+	c.Shutdown() // flushes the rest
+
+	// the legacy backup is not migrated twice
+	os.Remove(legacy)
+	c = NewShipStatCollector()
+	defer c.Shutdown()
+	if !c.OpenStore(storeFile, legacy) {
+		t.Fatalf("Reopen failed")
+	}
+	if counts := visitCounts(c); counts["Sol"] != 2 || counts["Lave"] != 2 || counts["Achenar"] != 2 {
+		t.Fatalf("Unexpected stored counts: %v", counts)
+	}
+}
+
+func TestRestoreKeepsStatOnFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "statrestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	backup := filepath.Join(dir, "stat.json")
+
+	c := NewShipStatCollector()
+	defer c.Shutdown()
+	c.Replay(testJumps("Sol"))
+	if !c.Backup(backup) {
+		t.Fatalf("Backup failed")
+	}
+	data, _ := ioutil.ReadFile(backup)
+	ioutil.WriteFile(backup, append([]byte("{broken\n"), data...), 0644)
+	c.Replay(testJumps("Lave"))
+	if !c.Restore(backup) {
+		t.Fatalf("Restore with a broken line failed")
+	}
+	if counts := visitCounts(c); len(counts) != 1 || counts["Sol"] != 1 {
+		t.Fatalf("Unexpected restored counts: %v", counts)
+	}
+	if c.Restore(filepath.Join(dir, "missing.json")) {
+		t.Fatalf("Restore of a missing file succeeded")
+	}
+	if counts := visitCounts(c); len(counts) != 1 {
+		t.Fatalf("The stat is lost: %v", counts)
+	}
+}
@@ -0,0 +1,278 @@
+package eddb
+
+import (
+	"errors"
+	"goed/edGalaxy"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	maxTradeJumpRange = 100
+)
+
+var padSizes = map[string]int{"S": 1, "M": 2, "L": 3}
+
+/*
+	The listings bound to a station: what can be bought there
+	and what the station pays for.
+*/
+type stationMarket struct {
+	station *StationRecordV5
+	updated int64                    // the time of the source the listings come from
+	selling []*ListingRecordV5       // the station has supply
+	buying  map[int]*ListingRecordV5 // the station has demand, by commodity id
+}
+
+type tradePlace struct {
+	market   *stationMarket
+	system   *SystemRecordV5
+	distance float64 // from the system the search was made around
+}
+
+type tradeSearch struct {
+	info         *EDDBInfo
+	liveMarkets  map[int]*stationMarket // by station id
+	capacity     int
+	jumpRange    float64
+	minPad       int
+	oldestUpdate int64
+	places       map[int][]*tradePlace // by system id
+}
+
+func buildStationMarkets(commodities *map[int]*CommodityRecordV5) map[int]*stationMarket {
+	markets := make(map[int]*stationMarket)
+	getMarket := func(st *StationRecordV5) *stationMarket {
+		m, exists := markets[st.Id]
+		if !exists {
+			m = &stationMarket{station: st, updated: st.MarketUpdated, selling: make([]*ListingRecordV5, 0), buying: make(map[int]*ListingRecordV5)}
+			markets[st.Id] = m
+		}
+		return m
+	}
+	for _, c := range *commodities {
+		for _, l := range c.Selling {
+			if l.Station != nil {
+				m := getMarket(l.Station)
+				m.selling = append(m.selling, l)
+			}
+		}
+		for _, l := range c.Buying {
+			if l.Station != nil {
+				getMarket(l.Station).buying[c.Id] = l
+			}
+		}
+	}
+	return markets
+}
+
+/*
+	The station market made of the live snapshot,
+	the commodities unknown to the dump are skipped
+*/
+func (i *EDDBInfo) liveStationMarket(st *StationRecordV5, live *LiveMarket) *stationMarket {
+	m := &stationMarket{station: st, updated: live.Updated, selling: make([]*ListingRecordV5, 0), buying: make(map[int]*ListingRecordV5)}
+	for k := range live.Commodities {
+		p := &live.Commodities[k]
+		c, known := i.commoditySyms[normalizeCommodityName(p.Name)]
+		if !known {
+			continue
+		}
+		l := liveListing(st, c, live, p)
+		if p.Stock > 0 {
+			m.selling = append(m.selling, l)
+		}
+		if p.SellPrice > 0 {
+			m.buying[c.Id] = l
+		}
+	}
+	return m
+}
+
+/*
+	The live market of the station when it is fresher than the dump one,
+	made once per search
+*/
+func (t *tradeSearch) market(st *StationRecordV5) (*stationMarket, bool) {
+	if m, made := t.liveMarkets[st.Id]; made {
+		return m, true
+	}
+	if live := t.info.getLiveMarket(st); live != nil {
+		m := t.info.liveStationMarket(st, live)
+		t.liveMarkets[st.Id] = m
+		return m, true
+	}
+	m, exists := t.info.markets[st.Id]
+	return m, exists
+}
+
+/*
+	The stations of unknown pads are taken unless a large pad is asked for
+*/
+func padFits(st *StationRecordV5, minPad int) bool {
+	size, known := padSizes[strings.ToUpper(st.MaxLandingPad)]
+	if !known {
+		return minPad < padSizes["L"]
+	}
+	return size >= minPad
+}
+
+/*
+	The stations with a fresh enough market inside one jump from the system,
+	the closest first
+*/
+func (t *tradeSearch) placesNear(s *SystemRecordV5) []*tradePlace {
+	if places, cached := t.places[s.Id]; cached {
+		return places
+	}
+	places := make([]*tradePlace, 0)
+	t.info.systemsInRange(s.GetCoordinates(), t.jumpRange, func(ss *SystemRecordV5, distance float64) bool {
+		for _, st := range t.info.systemStations[ss.Id] {
+			m, hasMarket := t.market(st)
+			if !hasMarket || !padFits(st, t.minPad) || m.updated < t.oldestUpdate {
+				continue
+			}
+			places = append(places, &tradePlace{market: m, system: ss, distance: distance})
+		}
+		return true
+	})
+	sort.Slice(places, func(i, j int) bool {
+		if places[i].distance != places[j].distance {
+			return places[i].distance < places[j].distance
+		}
+		return places[i].market.station.Id < places[j].market.station.Id
+	})
+	t.places[s.Id] = places
+	return places
+}
+
+/*
+	The most profitable full load to carry from one station to the other, nil if nothing pays
+*/
+func (t *tradeSearch) bestHop(from, to *tradePlace) *edGalaxy.TradeHop {
+	var best *edGalaxy.TradeHop
+	for _, offer := range from.market.selling {
+		if offer.Buy_price <= 0 || offer.Supply <= 0 || offer.Commodity == nil {
+			continue
+		}
+		bid, wanted := to.market.buying[offer.Commodity.Id]
+		if !wanted || bid.Demand <= 0 || bid.Sell_price <= offer.Buy_price {
+			continue
+		}
+		units := t.capacity
+		if offer.Supply < units {
+			units = offer.Supply
+		}
+		if bid.Demand < units {
+			units = bid.Demand
+		}
+		profit := int64(units) * int64(bid.Sell_price-offer.Buy_price)
+		if best != nil && (profit < best.Profit || (profit == best.Profit && bid.Sell_price-offer.Buy_price <= best.ProfitPerTon())) {
+			continue
+		}
+		best = &edGalaxy.TradeHop{
+			Commodity:   offer.Commodity.Name,
+			FromStation: from.market.station.Name,
+			FromSystem:  from.system.Name,
+			ToStation:   to.market.station.Name,
+			ToSystem:    to.system.Name,
+			BuyPrice:    offer.Buy_price,
+			SellPrice:   bid.Sell_price,
+			Units:       units,
+			Profit:      profit,
+			Distance:    from.system.GetCoordinates().Distance(to.system.GetCoordinates())}
+	}
+	return best
+}
+
+/*
+	FindTradeRoutes looks for the best single hops and A->B->A loops.
+	The trade starts at a station inside one jump from the system,
+	the destination is inside one jump from the start.
+	maxUpdateAge is in seconds. The live market of a visited station
+	is preferred when it is fresher than the dump.
+*/
+func (i *EDDBInfo) FindTradeRoutes(sName string, capacity int, jumpRange float64, minPad string, maxUpdateAge int64, maxRoutes int) (*edGalaxy.TradeRoutes, error) {
+	if capacity <= 0 {
+		return nil, errors.New("Cargo capacity must be positive")
+	}
+	if jumpRange <= 0 || jumpRange > maxTradeJumpRange {
+		return nil, errors.New("Jump range is out of limits")
+	}
+	pad := 1
+	if len(minPad) > 0 {
+		var known bool
+		pad, known = padSizes[strings.ToUpper(minPad)]
+		if !known {
+			return nil, errors.New("Landing pad must be one of S, M or L")
+		}
+	}
+	start, ok := i.GetSystemByName(sName)
+	if !ok {
+		return nil, errors.New("Unknown system")
+	}
+
+	t := &tradeSearch{
+		info:         i,
+		liveMarkets:  make(map[int]*stationMarket),
+		capacity:     capacity,
+		jumpRange:    jumpRange,
+		minPad:       pad,
+		oldestUpdate: time.Now().Unix() - maxUpdateAge,
+		places:       make(map[int][]*tradePlace),
+	}
+
+	hops := make([]*edGalaxy.TradeHop, 0)
+	loops := make([]*edGalaxy.TradeRoundTrip, 0)
+	// a loop is reported once, starting at the station closer to the system
+	seenLoops := make(map[[2]int]bool)
+	for _, a := range t.placesNear(start) {
+		for _, b := range t.placesNear(a.system) {
+			if a.market == b.market {
+				continue
+			}
+			out := t.bestHop(a, b)
+			if out == nil {
+				continue
+			}
+			hops = append(hops, out)
+
+			key := [2]int{a.market.station.Id, b.market.station.Id}
+			if key[0] > key[1] {
+				key[0], key[1] = key[1], key[0]
+			}
+			if seenLoops[key] {
+				continue
+			}
+			back := t.bestHop(b, a)
+			if back == nil {
+				continue
+			}
+			seenLoops[key] = true
+			loops = append(loops, &edGalaxy.TradeRoundTrip{Outbound: out, Inbound: back, Profit: out.Profit + back.Profit})
+		}
+	}
+
+	sort.Slice(hops, func(i, j int) bool {
+		if hops[i].Profit != hops[j].Profit {
+			return hops[i].Profit > hops[j].Profit
+		}
+		return hops[i].Distance < hops[j].Distance
+	})
+	sort.Slice(loops, func(i, j int) bool {
+		if loops[i].Profit != loops[j].Profit {
+			return loops[i].Profit > loops[j].Profit
+		}
+		return loops[i].Outbound.Distance < loops[j].Outbound.Distance
+	})
+	if maxRoutes > 0 {
+		if len(hops) > maxRoutes {
+			hops = hops[:maxRoutes]
+		}
+		if len(loops) > maxRoutes {
+			loops = loops[:maxRoutes]
+		}
+	}
+	return &edGalaxy.TradeRoutes{Hops: hops, RoundTrips: loops}, nil
+}
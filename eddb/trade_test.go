@@ -0,0 +1,119 @@
+package eddb
+
+import (
+	"testing"
+	"time"
+)
+
+func buildTradeEDDBInfo() *EDDBInfo {
+	now := time.Now().Unix()
+	systems := map[int]*SystemRecordV5{
+		1: &SystemRecordV5{Id: 1, Name: "Alpha"},
+		2: &SystemRecordV5{Id: 2, Name: "Beta", X: 10},
+		3: &SystemRecordV5{Id: 3, Name: "Gamma", X: 50},
+	}
+	stations := map[int]*StationRecordV5{
+		1: &StationRecordV5{Id: 1, Name: "Alpha Port", SystemId: 1, MaxLandingPad: "L", MarketUpdated: now},
+		2: &StationRecordV5{Id: 2, Name: "Beta Dock", SystemId: 2, MaxLandingPad: "L", MarketUpdated: now},
+		3: &StationRecordV5{Id: 3, Name: "Gamma Hub", SystemId: 3, MaxLandingPad: "L", MarketUpdated: now},
+		4: &StationRecordV5{Id: 4, Name: "Beta Outpost", SystemId: 2, MaxLandingPad: "M", MarketUpdated: now},
+	}
+	gold := &CommodityRecordV5{Id: 1, Name: "Gold", Selling: make(map[int]*ListingRecordV5), Buying: make(map[int]*ListingRecordV5)}
+	silver := &CommodityRecordV5{Id: 2, Name: "Silver", Selling: make(map[int]*ListingRecordV5), Buying: make(map[int]*ListingRecordV5)}
+	gold.Selling[1] = &ListingRecordV5{Id: 1, Station: stations[1], Commodity: gold, Supply: 1000, Buy_price: 100}
+	gold.Buying[2] = &ListingRecordV5{Id: 2, Station: stations[2], Commodity: gold, Demand: 50, Sell_price: 300}
+	// pays more, but it is too far away
+	gold.Buying[3] = &ListingRecordV5{Id: 3, Station: stations[3], Commodity: gold, Demand: 1000, Sell_price: 1000}
+	// pays more, but the pad is too small
+	gold.Buying[4] = &ListingRecordV5{Id: 4, Station: stations[4], Commodity: gold, Demand: 1000, Sell_price: 900}
+	silver.Selling[5] = &ListingRecordV5{Id: 5, Station: stations[2], Commodity: silver, Supply: 1000, Buy_price: 50}
+	silver.Buying[6] = &ListingRecordV5{Id: 6, Station: stations[1], Commodity: silver, Demand: 1000, Sell_price: 90}
+
+	commodities := map[int]*CommodityRecordV5{1: gold, 2: silver}
+	factions := make(map[int]*FactionRecordV5)
+	primaryStars := make(map[int]*BodyRecordV5)
+	return newEDDBInfo(&commodities, &systems, &stations, &factions, &primaryStars)
+}
+
+func TestFindTradeRoutes(t *testing.T) {
+	info := buildTradeEDDBInfo()
+
+	routes, err := info.FindTradeRoutes("alpha", 100, 20, "L", 3600, 10)
+	if err != nil {
+		t.Fatalf("FindTradeRoutes failed: %v", err)
+	}
+	if len(routes.Hops) != 2 {
+		t.Fatalf("Expected 2 hops, got %d", len(routes.Hops))
+	}
+	best := routes.Hops[0]
+	if best.Commodity != "Gold" || best.ToStation != "Beta Dock" || best.Units != 50 || best.Profit != 10000 {
+		t.Fatalf("Unexpected best hop: %+v", best)
+	}
+	if len(routes.RoundTrips) != 1 {
+		t.Fatalf("Expected 1 round trip, got %d", len(routes.RoundTrips))
+	}
+	loop := routes.RoundTrips[0]
+	if loop.Inbound.Commodity != "Silver" || loop.Inbound.Units != 100 || loop.Profit != 14000 {
+		t.Fatalf("Unexpected round trip: %+v / %+v", loop.Outbound, loop.Inbound)
+	}
+
+	routes, err = info.FindTradeRoutes("alpha", 100, 20, "M", 3600, 10)
+	if err != nil {
+		t.Fatalf("FindTradeRoutes failed: %v", err)
+	}
+	if routes.Hops[0].ToStation != "Beta Outpost" {
+		t.Fatalf("Expected the medium pad station to win, got %s", routes.Hops[0].ToStation)
+	}
+
+	if _, err = info.FindTradeRoutes("alpha", 0, 20, "L", 3600, 10); err == nil {
+		t.Fatalf("Expected an error for zero capacity")
+	}
+}
+
+func TestFindSellPlaces(t *testing.T) {
+	info := buildTradeEDDBInfo()
+
+	points, err := info.FindSellPlaces("gold", "Alpha", 100, "M", true, 0, 100, 3600)
+	if err != nil {
+		t.Fatalf("FindSellPlaces failed: %v", err)
+	}
+	expected := []string{"Gamma Hub", "Beta Outpost", "Beta Dock"}
+	if len(points) != len(expected) {
+		t.Fatalf("Expected %d places, got %d", len(expected), len(points))
+	}
+	for i, p := range points {
+		if p.station.Name != expected[i] {
+			t.Fatalf("Place %d: expected %s, got %s", i, expected[i], p.station.Name)
+		}
+	}
+
+	// the demand at Beta Dock is too low for the whole load
+	points, err = info.FindSellPlaces("gold", "Alpha", 100, "L", true, 0, 20, 3600)
+	if err != nil || len(points) != 1 || points[0].sellCredits(100) != 15000 {
+		t.Fatalf("Expected only Beta Dock paying 15000: %v", err)
+	}
+}
+
+func TestFindTradeRoutesLiveMarkets(t *testing.T) {
+	info := buildTradeEDDBInfo()
+	(*info.stations)[2].EdMarketId = 102
+	info = newEDDBInfo(info.commodities, info.systems, info.stations, info.factions, info.primaryStars)
+	markets := NewLiveMarkets()
+	info.SetLiveMarkets(markets)
+
+	// Beta Dock pays more for gold now
+	markets.OnMarket(nil, &CommodityMessage{MarketID: 102, Timestamp: time.Unix((*info.stations)[2].MarketUpdated+60, 0),
+		Commodities: []CommodityPrice{{Name: "gold", SellPrice: 500, Demand: 50}, {Name: "silver", BuyPrice: 50, Stock: 1000}}})
+
+	routes, err := info.FindTradeRoutes("alpha", 100, 20, "L", 3600, 10)
+	if err != nil {
+		t.Fatalf("FindTradeRoutes failed: %v", err)
+	}
+	if len(routes.Hops) == 0 {
+		t.Fatalf("No hops found")
+	}
+	best := routes.Hops[0]
+	if best.ToStation != "Beta Dock" || best.SellPrice != 500 || best.Profit != 20000 {
+		t.Fatalf("Unexpected best hop: %+v", best)
+	}
+}
@@ -0,0 +1,272 @@
+package eddb
+
+import (
+	"encoding/json"
+	"errors"
+	"goed/edGalaxy"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	uploaderTimeframe     = 3600
+	maxUploaderPlaces     = 32 // per uploader and hour
+	defaultUploaderWindow = 24 * 3600
+	maxUploaderFrames     = 7 * 24
+	unknownSoftware       = "Unknown"
+)
+
+type uploaderPlace struct {
+	System   string           `json:"system"`
+	Coords   edGalaxy.Point3D `json:"coords"`
+	Software string           `json:"software"`
+	Messages int64            `json:"messages"`
+}
+
+type uploaderHour struct {
+	Timemark int64            `json:"time_mark"` // unix time / uploaderTimeframe
+	Places   []*uploaderPlace `json:"places"`
+}
+
+type uploaderTrack struct {
+	UploaderID string          `json:"uploader_id"`
+	Hours      []*uploaderHour `json:"hours"` // the oldest first
+}
+
+/*
+	UploaderRegistry keeps the places every EDDN uploader reported from by the hour,
+	so the distinct uploaders of a region are counted instead of the jumps
+	a single busy explorer can inflate. The relay hashes the uploader ids,
+	an id is the best estimate of a commander there is.
+*/
+type UploaderRegistry struct {
+	mtx       sync.RWMutex
+	uploaders map[string]*uploaderTrack
+}
+
+func NewUploaderRegistry() *UploaderRegistry {
+	return &UploaderRegistry{uploaders: make(map[string]*uploaderTrack)}
+}
+
+/*
+	Over maxUploaderPlaces the messages are counted in the last place of the hour
+*/
+func (r *UploaderRegistry) note(m *EDDNMessage, system string, starPos []float64, ts time.Time) {
+	coords := starPos2point(starPos)
+	if len(m.Header.UploaderID) == 0 || coords == nil {
+		return
+	}
+	software := strings.TrimSpace(m.Header.SoftwareName)
+	if len(software) == 0 {
+		software = unknownSoftware
+	}
+	mark := ts.Unix() / uploaderTimeframe
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	track, exists := r.uploaders[m.Header.UploaderID]
+	if !exists {
+		track = &uploaderTrack{UploaderID: m.Header.UploaderID}
+		r.uploaders[m.Header.UploaderID] = track
+	}
+	hour := track.hour(mark)
+	for _, p := range hour.Places {
+		if p.Software == software && strings.EqualFold(p.System, system) {
+			p.Messages++
+			return
+		}
+	}
+	if len(hour.Places) >= maxUploaderPlaces {
+		hour.Places[len(hour.Places)-1].Messages++
+		return
+	}
+	hour.Places = append(hour.Places, &uploaderPlace{System: system, Coords: *coords, Software: software, Messages: 1})
+}
+
+/*
+	The hour is added if it is not there, the late ones are put in order
+*/
+func (t *uploaderTrack) hour(mark int64) *uploaderHour {
+	i := len(t.Hours)
+	for i > 0 && t.Hours[i-1].Timemark >= mark {
+		if t.Hours[i-1].Timemark == mark {
+			return t.Hours[i-1]
+		}
+		i--
+	}
+	h := &uploaderHour{Timemark: mark}
+	t.Hours = append(t.Hours, nil)
+	copy(t.Hours[i+1:], t.Hours[i:])
+	t.Hours[i] = h
+	return h
+}
+
+/*
+	FSDJumpListener implementation, FSDJump, CarrierJump and Location are all taken
+*/
+func (r *UploaderRegistry) OnFSDJump(m *EDDNMessage, jump *FSDJumpMessage) {
+	r.note(m, jump.StarSystem, jump.StarPos, jump.Timestamp)
+}
+
+/*
+	DockedListener implementation
+*/
+func (r *UploaderRegistry) OnDocked(m *EDDNMessage, docked *DockedMessage) {
+	r.note(m, docked.StarSystem, docked.StarPos, docked.Timestamp)
+}
+
+/*
+	ScanListener implementation
+*/
+func (r *UploaderRegistry) OnScan(m *EDDNMessage, scan *ScanMessage) {
+	r.note(m, scan.StarSystem, scan.StarPos, scan.Timestamp)
+}
+
+/*
+	The uploaders seen within maxDistance for the last window seconds (a day if 0)
+	by the hour, and the messages by the client tool.
+*/
+func (r *UploaderRegistry) GetUploaderStat(coords *edGalaxy.Point3D, maxDistance float64, window int64) (*edGalaxy.UploaderStat, error) {
+	if coords == nil {
+		return nil, errors.New("The origin is not known")
+	}
+	if window <= 0 {
+		window = defaultUploaderWindow
+	}
+	frames := (window + uploaderTimeframe - 1) / uploaderTimeframe
+	if frames > maxUploaderFrames {
+		frames = maxUploaderFrames
+	}
+	ctf := time.Now().Unix() / uploaderTimeframe
+	since := ctf - frames + 1
+
+	stat := &edGalaxy.UploaderStat{Hours: make([]*edGalaxy.UploaderStatItem, frames)}
+	for i := range stat.Hours {
+		stat.Hours[i] = &edGalaxy.UploaderStatItem{Timestamp: (ctf - int64(i)) * uploaderTimeframe}
+	}
+	software := make(map[string]*edGalaxy.SoftwareShare)
+
+	r.mtx.RLock()
+	for _, track := range r.uploaders {
+		seen := false
+		tools := make(map[string]bool)
+		for _, h := range track.Hours {
+			if h.Timemark < since || h.Timemark > ctf {
+				continue
+			}
+			seenThisHour := false
+			for _, p := range h.Places {
+				if coords.Distance(&p.Coords) > maxDistance {
+					continue
+				}
+				seenThisHour = true
+				item := stat.Hours[ctf-h.Timemark]
+				item.Messages += p.Messages
+				stat.Messages += p.Messages
+				share, exists := software[p.Software]
+				if !exists {
+					share = &edGalaxy.SoftwareShare{Name: p.Software}
+					software[p.Software] = share
+				}
+				share.Messages += p.Messages
+				if !tools[p.Software] {
+					tools[p.Software] = true
+					share.Uploaders++
+				}
+			}
+			if seenThisHour {
+				seen = true
+				stat.Hours[ctf-h.Timemark].Uploaders++
+			}
+		}
+		if seen {
+			stat.Uploaders++
+		}
+	}
+	r.mtx.RUnlock()
+
+	stat.Software = make([]*edGalaxy.SoftwareShare, 0, len(software))
+	for _, share := range software {
+		stat.Software = append(stat.Software, share)
+	}
+	sort.Slice(stat.Software, func(i, j int) bool {
+		if stat.Software[i].Messages != stat.Software[j].Messages {
+			return stat.Software[i].Messages > stat.Software[j].Messages
+		}
+		return stat.Software[i].Name < stat.Software[j].Name
+	})
+	return stat, nil
+}
+
+func (r *UploaderRegistry) Len() int {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return len(r.uploaders)
+}
+
+/*
+	Drops the hours older than maxAge and the uploaders left without hours
+*/
+func (r *UploaderRegistry) Expire(maxAge time.Duration) {
+	oldest := time.Now().Add(-maxAge).Unix() / uploaderTimeframe
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for id, track := range r.uploaders {
+		n := 0
+		for n < len(track.Hours) && track.Hours[n].Timemark < oldest {
+			n++
+		}
+		if n == len(track.Hours) {
+			delete(r.uploaders, id)
+			continue
+		}
+		track.Hours = track.Hours[n:]
+	}
+}
+
+func (r *UploaderRegistry) Backup(fileName string) bool {
+	r.mtx.RLock()
+	err := writeJSONLines(fileName, func(enc *json.Encoder) error {
+		for _, track := range r.uploaders {
+			if err := enc.Encode(track); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	r.mtx.RUnlock()
+	if err != nil {
+		log.Printf("Uploaders backup to %s failed: %v\n", fileName, err)
+		return false
+	}
+	log.Printf("Uploaders backup to %s succeeded\n", fileName)
+	return true
+}
+
+/*
+	The current uploaders are kept if the file can not be read to the end
+*/
+func (r *UploaderRegistry) Restore(fileName string) bool {
+	uploaders := make(map[string]*uploaderTrack)
+	_, err := readJSONLines(fileName, func(line []byte) error {
+		var track uploaderTrack
+		if err := json.Unmarshal(line, &track); err != nil || len(track.UploaderID) == 0 {
+			log.Printf("Error unmarshaling uploader: %v\n", err)
+			return errors.New("Broken uploader")
+		}
+		uploaders[track.UploaderID] = &track
+		return nil
+	})
+	if err != nil {
+		log.Printf("Uploaders restore from %s failed: %v\n", fileName, err)
+		return false
+	}
+	r.mtx.Lock()
+	r.uploaders = uploaders
+	r.mtx.Unlock()
+	log.Printf("Uploaders restore from %s succeeded: got %d uploaders\n", fileName, len(uploaders))
+	return true
+}
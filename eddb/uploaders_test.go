@@ -0,0 +1,66 @@
+package eddb
+
+import (
+	"goed/edGalaxy"
+	"testing"
+	"time"
+)
+
+func testUploaderMessage(uploader string, software string) *EDDNMessage {
+	m := &EDDNMessage{SchemaRef: "https://eddn.edcd.io/schemas/journal/1"}
+	m.Header.UploaderID = uploader
+	m.Header.SoftwareName = software
+	return m
+}
+
+func TestUploaderRegistry(t *testing.T) {
+	now := time.Now()
+	r := NewUploaderRegistry()
+	jump := func(uploader string, software string, system string, pos []float64, ts time.Time) {
+		r.OnFSDJump(testUploaderMessage(uploader, software), &FSDJumpMessage{Event: "FSDJump", StarSystem: system, StarPos: pos, Timestamp: ts})
+	}
+	// the busy explorer
+	for i := 0; i < 50; i++ {
+		jump("explorer", "EDDiscovery", "Sol", []float64{0, 0, 0}, now.Add(-time.Duration(i)*time.Minute))
+	}
+	jump("trader", "E:D Market Connector", "Alpha Centauri", []float64{3.03125, -0.09375, 3.15625}, now)
+	r.OnDocked(testUploaderMessage("trader", "E:D Market Connector"),
+		&DockedMessage{StarSystem: "Alpha Centauri", StarPos: []float64{3.03125, -0.09375, 3.15625}, StationName: "Hutton Orbital", Timestamp: now})
+	jump("far", "EDDiscovery", "Colonia", []float64{-9530.5, -910.28125, 19808.125}, now)
+	jump("old", "EDDiscovery", "Sol", []float64{0, 0, 0}, now.Add(-3*24*time.Hour))
+	jump("", "EDDiscovery", "Sol", []float64{0, 0, 0}, now)
+
+	stat, err := r.GetUploaderStat(&edGalaxy.Point3D{}, 100, 0)
+	if err != nil {
+		t.Fatalf("GetUploaderStat failed: %v", err)
+	}
+	if stat.Uploaders != 2 || stat.Messages != 52 || len(stat.Hours) != 24 {
+		t.Fatalf("Unexpected stat: %d uploaders, %d messages, %d hours", stat.Uploaders, stat.Messages, len(stat.Hours))
+	}
+	if stat.Hours[0].Uploaders != 2 || stat.Hours[0].Timestamp != now.Unix()/3600*3600 {
+		t.Fatalf("Unexpected last hour: %+v", stat.Hours[0])
+	}
+	if len(stat.Software) != 2 || stat.Software[0].Name != "EDDiscovery" || stat.Software[0].Messages != 50 ||
+		stat.Software[1].Uploaders != 1 || stat.Software[1].Messages != 2 {
+		t.Fatalf("Unexpected software shares: %+v, %+v", stat.Software[0], stat.Software[len(stat.Software)-1])
+	}
+	if stat, _ = r.GetUploaderStat(&edGalaxy.Point3D{}, 100, 7*24*3600); stat.Uploaders != 3 || len(stat.Hours) != 7*24 {
+		t.Fatalf("Unexpected stat for a week: %d uploaders", stat.Uploaders)
+	}
+	if stat, _ = r.GetUploaderStat(&edGalaxy.Point3D{}, 100, 30*24*3600); stat.Uploaders != 3 || stat.Window() != 7*24*3600 {
+		t.Fatalf("A month is expected to be cut to a week, got %d seconds", stat.Window())
+	}
+
+	restored := NewUploaderRegistry()
+	testRoundTrip(t, r.Backup, restored.Restore)
+	if restored.Len() != 4 {
+		t.Fatalf("Expected 4 restored uploaders, got %d", restored.Len())
+	}
+	restored.Expire(24 * time.Hour)
+	if restored.Len() != 3 {
+		t.Fatalf("Expected 3 uploaders after expire, got %d", restored.Len())
+	}
+	if stat, _ = restored.GetUploaderStat(&edGalaxy.Point3D{}, 100, 0); stat.Uploaders != 2 || stat.Messages != 52 {
+		t.Fatalf("Unexpected restored stat: %d uploaders, %d messages", stat.Uploaders, stat.Messages)
+	}
+}
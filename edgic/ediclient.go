@@ -22,11 +22,15 @@ func NewEDInfoCenterClient(addr string) *EDInfoCenterClient {
 type rpccallproc func(pb.EDInfoCenterClient, context.Context)
 
 func callRpc(addr string, rpcCall rpccallproc) error {
+	return callRpcWithTimeout(addr, time.Second, rpcCall)
+}
+
+func callRpcWithTimeout(addr string, timeout time.Duration, rpcCall rpccallproc) error {
 	if len(addr) < 5 {
 		return errors.New("Galaxy information server is not configured")
 	}
 	log.Printf("Dialing info center '%s'\n", addr)
-	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithUnaryInterceptor(clientMetricsInterceptor))
 	if err != nil {
 		log.Printf("did not connect: %v", err)
 		return errors.New("Galaxy information server is not available")
@@ -35,7 +39,7 @@ func callRpc(addr string, rpcCall rpccallproc) error {
 	c := pb.NewEDInfoCenterClient(conn)
 
 	// Contact the server and print out its response.
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	rpcCall(c, ctx)
@@ -100,14 +104,18 @@ func (cc *EDInfoCenterClient) GetHumanWorldStat() (*edGalaxy.HumanWorldStat, err
 		Population:    stat.GetPopulation()}, nil
 }
 
-func (cc *EDInfoCenterClient) GetGalaxyActivityStat(systemName string, maxDistance float64) ([]*edGalaxy.ActivityStatItem, error) {
+/*
+	The window is in seconds, 0 - a week
+*/
+func (cc *EDInfoCenterClient) GetGalaxyActivityStat(systemName string, maxDistance float64, window int64) ([]*edGalaxy.ActivityStatItem, error) {
 	var rpl *pb.ActivityStatReply
 	var cerr error = nil
 
 	statcall := func(c pb.EDInfoCenterClient, ctx context.Context) {
 		rpl, cerr = c.GetGalaxyActivityStat(ctx, &pb.ActivityStatRequest{
 			Origin:      systemName,
-			MaxDistance: maxDistance})
+			MaxDistance: maxDistance,
+			Window:      window})
 	}
 	err := callRpc(cc.addr, statcall)
 
@@ -122,14 +130,141 @@ func (cc *EDInfoCenterClient) GetGalaxyActivityStat(systemName string, maxDistan
 	return pbActivityStatItems2galaxyActivityStatItems(rpl.GetStatItems()), nil
 }
 
-func (cc *EDInfoCenterClient) GetMostVisitedSystems(systemName string, maxDistance float64, limit int) ([]*edGalaxy.SystemVisitsStatCalculated, int64, error) {
+/*
+	The systems visited far above their usual for the last window seconds, 0 - 3 hours
+*/
+func (cc *EDInfoCenterClient) GetTrendingSystems(systemName string, maxDistance float64, window int64, limit int) ([]*edGalaxy.TrendingSystem, error) {
+	var rpl *pb.TrendingSystemsReply
+	var cerr error = nil
+
+	statcall := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetTrendingSystems(ctx, &pb.TrendingSystemsRequest{
+			Origin:      systemName,
+			MaxDistance: maxDistance,
+			Window:      window,
+			Limit:       int64(limit)})
+	}
+	err := callRpc(cc.addr, statcall)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get trending systems: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+	trending := make([]*edGalaxy.TrendingSystem, 0, len(rpl.GetSystems()))
+	for _, t := range rpl.GetSystems() {
+		if t != nil {
+			trending = append(trending, &edGalaxy.TrendingSystem{
+				Name:         t.GetName(),
+				Distance:     t.GetDistance(),
+				Visits:       t.GetVisits(),
+				Rate:         t.GetRate(),
+				BaselineRate: t.GetBaselineRate(),
+				Score:        t.GetScore()})
+		}
+	}
+	return trending, nil
+}
+
+/*
+	The EDDN uploaders seen for the last window seconds, 0 - a day
+*/
+func (cc *EDInfoCenterClient) GetUploaderStat(systemName string, maxDistance float64, window int64) (*edGalaxy.UploaderStat, error) {
+	var rpl *pb.UploaderStatReply
+	var cerr error = nil
+
+	statcall := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetUploaderStat(ctx, &pb.UploaderStatRequest{
+			Origin:      systemName,
+			MaxDistance: maxDistance,
+			Window:      window})
+	}
+	err := callRpc(cc.addr, statcall)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get uploader stat: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+	stat := &edGalaxy.UploaderStat{
+		Uploaders: rpl.GetUploaders(),
+		Messages:  rpl.GetMessages(),
+		Hours:     make([]*edGalaxy.UploaderStatItem, 0, len(rpl.GetHours())),
+		Software:  make([]*edGalaxy.SoftwareShare, 0, len(rpl.GetSoftware()))}
+	for _, h := range rpl.GetHours() {
+		if h != nil {
+			stat.Hours = append(stat.Hours, &edGalaxy.UploaderStatItem{
+				Timestamp: h.GetTimestamp(),
+				Uploaders: h.GetUploaders(),
+				Messages:  h.GetMessages()})
+		}
+	}
+	for _, sw := range rpl.GetSoftware() {
+		if sw != nil {
+			stat.Software = append(stat.Software, &edGalaxy.SoftwareShare{
+				Name:      sw.GetName(),
+				Uploaders: sw.GetUploaders(),
+				Messages:  sw.GetMessages()})
+		}
+	}
+	return stat, nil
+}
+
+/*
+	The busiest stations for the last window seconds, 0 - a day
+*/
+func (cc *EDInfoCenterClient) GetStationActivity(systemName string, maxDistance float64, window int64, limit int) ([]*edGalaxy.StationActivity, error) {
+	var rpl *pb.StationActivityReply
+	var cerr error = nil
+
+	statcall := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetStationActivity(ctx, &pb.StationActivityRequest{
+			Origin:      systemName,
+			MaxDistance: maxDistance,
+			Window:      window,
+			Limit:       int64(limit)})
+	}
+	err := callRpc(cc.addr, statcall)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get station activity: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+	return pbStationActivity2galaxyStationActivity(rpl.GetStations()), nil
+}
+
+/*
+	The max age is in seconds, 0 - all the kept stat
+*/
+func (cc *EDInfoCenterClient) GetMostVisitedSystems(systemName string, maxDistance float64, maxAge int64, limit int) ([]*edGalaxy.SystemVisitsStatCalculated, int64, error) {
 	var rpl *pb.MostVisitedSystemsReply
 	var cerr error = nil
 
 	statcall := func(c pb.EDInfoCenterClient, ctx context.Context) {
 		rpl, cerr = c.GetMostVisitedSystems(ctx, &pb.MostVisitedSystemsRequest{
 			Origin:      systemName,
-			MaxDistance: maxDistance, Limit: int64(limit)})
+			MaxDistance: maxDistance,
+			MaxAge:      maxAge,
+			Limit:       int64(limit)})
 	}
 	err := callRpc(cc.addr, statcall)
 
@@ -202,6 +337,459 @@ func (cc *EDInfoCenterClient) GetDockableStations(name string) ([]*edGalaxy.Dock
 	return stations, nil, nil
 }
 
+/*
+	The suggestions are either the commodity names or the system names,
+	depending on what was not found.
+*/
+func (cc *EDInfoCenterClient) FindCommodity(rq *edGalaxy.CommoditySearch) ([]*edGalaxy.SuitablePoint, error, []string) {
+	var rpl *pb.FindCommodityReply
+	var cerr error = nil
+
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.FindCommodity(ctx, &pb.FindCommodityRequest{
+			Commodity:        rq.Commodity,
+			Origin:           rq.Origin,
+			MinSupply:        int64(rq.MinSupply),
+			MinPad:           rq.MinPad,
+			AllowPlanetary:   rq.AllowPlanetary,
+			MaxLocalDistance: rq.MaxLocalDistance,
+			MaxDistance:      rq.MaxDistance,
+			MaxMarketAge:     rq.MaxMarketAge,
+			Limit:            int64(rq.Limit)})
+	}
+
+	err := callRpc(cc.addr, call)
+
+	if err != nil {
+		return nil, err, nil
+	}
+
+	if cerr != nil {
+		log.Printf("Could not find commodity: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction"), nil
+	}
+	return pbFindCommodityReply2galaxy(rpl)
+}
+
+/*
+	Same as FindCommodity, the best paying stations come first
+*/
+func (cc *EDInfoCenterClient) FindSellPlaces(rq *edGalaxy.CommoditySearch) ([]*edGalaxy.SuitablePoint, error, []string) {
+	var rpl *pb.FindCommodityReply
+	var cerr error = nil
+
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.FindSellPlaces(ctx, &pb.SellCommodityRequest{
+			Commodity:        rq.Commodity,
+			Origin:           rq.Origin,
+			Tonnage:          int64(rq.Tonnage),
+			MinPad:           rq.MinPad,
+			AllowPlanetary:   rq.AllowPlanetary,
+			MaxLocalDistance: rq.MaxLocalDistance,
+			MaxDistance:      rq.MaxDistance,
+			MaxMarketAge:     rq.MaxMarketAge,
+			Limit:            int64(rq.Limit)})
+	}
+
+	err := callRpc(cc.addr, call)
+
+	if err != nil {
+		return nil, err, nil
+	}
+
+	if cerr != nil {
+		log.Printf("Could not find sell places: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction"), nil
+	}
+	return pbFindCommodityReply2galaxy(rpl)
+}
+
+func pbFindCommodityReply2galaxy(rpl *pb.FindCommodityReply) ([]*edGalaxy.SuitablePoint, error, []string) {
+	if len(rpl.Error) != 0 {
+		if len(rpl.GetSuggestedCommodities()) > 0 {
+			return nil, errors.New(rpl.Error), rpl.GetSuggestedCommodities()
+		}
+		return nil, errors.New(rpl.Error), rpl.GetSuggestedSystems()
+	}
+
+	pbPoints := rpl.GetPoints()
+	points := make([]*edGalaxy.SuitablePoint, len(pbPoints))
+	for i, sp := range pbPoints {
+		points[i] = &edGalaxy.SuitablePoint{
+			Station:        sp.GetStation(),
+			System:         sp.GetSystem(),
+			LandingPad:     sp.GetLandingPad(),
+			Planetary:      sp.GetPlanetary(),
+			DistanceToStar: sp.GetDistanceToStar(),
+			BuyPrice:       int(sp.GetBuyPrice()),
+			Supply:         int(sp.GetSupply()),
+			SellPrice:      int(sp.GetSellPrice()),
+			Demand:         int(sp.GetDemand()),
+			Distance:       sp.GetDistance(),
+			MarketAge:      sp.GetMarketAge(),
+			PriceSource:    sp.GetPriceSource()}
+	}
+	return points, nil, nil
+}
+
+func (cc *EDInfoCenterClient) GetRoute(from string, to string, jumpRange float64, opts *edGalaxy.RouteOptions) (*edGalaxy.Route, error) {
+	var rpl *pb.RouteReply
+	var cerr error = nil
+
+	rq := &pb.RouteRequest{Origin: from, Destination: to, JumpRange: jumpRange}
+	if opts != nil {
+		rq.ScoopableOnly = opts.ScoopableOnly
+		rq.UseNeutrons = opts.UseNeutrons
+	}
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetRoute(ctx, rq)
+	}
+
+	// the route search may take a while on long trips
+	err := callRpcWithTimeout(cc.addr, 10*time.Second, call)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get route: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+
+	return pbRoute2galaxy(rpl), nil
+}
+
+func pbRoute2galaxy(rpl *pb.RouteReply) *edGalaxy.Route {
+	pbWaypoints := rpl.GetWaypoints()
+	waypoints := make([]*edGalaxy.RouteWaypoint, len(pbWaypoints))
+	for i, w := range pbWaypoints {
+		waypoints[i] = &edGalaxy.RouteWaypoint{
+			Name:         w.GetName(),
+			Coords:       pbPoint3D2galaxy(w.GetCoords()),
+			Distance:     w.GetDistance(),
+			PrimaryStar:  pbStarInfo2galaxy(w.GetPrimaryStar()),
+			Supercharged: w.GetSupercharged()}
+	}
+	return &edGalaxy.Route{
+		Waypoints:     waypoints,
+		Jumps:         int(rpl.GetJumps()),
+		TotalDistance: rpl.GetTotalDistance()}
+}
+
+func (cc *EDInfoCenterClient) FindTradeRoutes(origin string, capacity int, jumpRange float64, minPad string, maxMarketAge int64, limit int) (*edGalaxy.TradeRoutes, error) {
+	var rpl *pb.TradeRouteReply
+	var cerr error = nil
+
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.FindTradeRoutes(ctx, &pb.TradeRouteRequest{
+			Origin:       origin,
+			Capacity:     int64(capacity),
+			JumpRange:    jumpRange,
+			MinPad:       minPad,
+			MaxMarketAge: maxMarketAge,
+			Limit:        int64(limit)})
+	}
+
+	err := callRpcWithTimeout(cc.addr, 10*time.Second, call)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get trade routes: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+
+	pbHops := rpl.GetHops()
+	hops := make([]*edGalaxy.TradeHop, len(pbHops))
+	for i, h := range pbHops {
+		hops[i] = pbTradeHop2galaxy(h)
+	}
+	pbLoops := rpl.GetRoundTrips()
+	loops := make([]*edGalaxy.TradeRoundTrip, len(pbLoops))
+	for i, l := range pbLoops {
+		loops[i] = &edGalaxy.TradeRoundTrip{
+			Outbound: pbTradeHop2galaxy(l.GetOutbound()),
+			Inbound:  pbTradeHop2galaxy(l.GetInbound()),
+			Profit:   l.GetProfit()}
+	}
+	return &edGalaxy.TradeRoutes{Hops: hops, RoundTrips: loops}, nil
+}
+
+func pbTradeHop2galaxy(h *pb.TradeHop) *edGalaxy.TradeHop {
+	if h == nil {
+		return nil
+	}
+	return &edGalaxy.TradeHop{
+		Commodity:   h.GetCommodity(),
+		FromStation: h.GetFromStation(),
+		FromSystem:  h.GetFromSystem(),
+		ToStation:   h.GetToStation(),
+		ToSystem:    h.GetToSystem(),
+		BuyPrice:    int(h.GetBuyPrice()),
+		SellPrice:   int(h.GetSellPrice()),
+		Units:       int(h.GetUnits()),
+		Profit:      h.GetProfit(),
+		Distance:    h.GetDistance()}
+}
+
+func (cc *EDInfoCenterClient) GetSystemBodies(name string) ([]*edGalaxy.BodyInfo, error) {
+	var rpl *pb.SystemBodiesReply
+	var cerr error = nil
+
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetSystemBodies(ctx, &pb.SystemByNameRequest{Name: name})
+	}
+
+	err := callRpc(cc.addr, call)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get system bodies: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+
+	pbBodies := rpl.GetBodies()
+	bodies := make([]*edGalaxy.BodyInfo, len(pbBodies))
+	for i, b := range pbBodies {
+		bodies[i] = &edGalaxy.BodyInfo{
+			Name:                b.GetName(),
+			BodyID:              int(b.GetBodyId()),
+			IsStar:              b.GetIsStar(),
+			Type:                b.GetType(),
+			DistanceFromArrival: b.GetDistanceFromArrival(),
+			Landable:            b.GetLandable(),
+			TerraformState:      b.GetTerraformState(),
+			Atmosphere:          b.GetAtmosphere(),
+			RingClasses:         b.GetRingClasses(),
+			WasDiscovered:       b.GetWasDiscovered(),
+			WasMapped:           b.GetWasMapped(),
+			Updated:             b.GetUpdated()}
+	}
+	return bodies, nil
+}
+
+func pbCarrierInfo2galaxy(c *pb.CarrierInfo) *edGalaxy.CarrierInfo {
+	gc := &edGalaxy.CarrierInfo{
+		Callsign: c.GetCallsign(),
+		MarketID: c.GetMarketId(),
+		System:   c.GetSystem(),
+		Services: c.GetServices(),
+		LastSeen: c.GetLastSeen(),
+		Distance: c.GetDistance()}
+	if pc := c.GetCoords(); pc != nil {
+		gc.Coords = &edGalaxy.Point3D{X: pc.GetX(), Y: pc.GetY(), Z: pc.GetZ()}
+	}
+	return gc
+}
+
+func (cc *EDInfoCenterClient) GetCarrier(callsign string) (*edGalaxy.CarrierInfo, error) {
+	var rpl *pb.CarrierReply
+	var cerr error = nil
+
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetCarrier(ctx, &pb.CarrierRequest{Callsign: callsign})
+	}
+
+	err := callRpc(cc.addr, call)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get carrier: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+	return pbCarrierInfo2galaxy(rpl.GetCarrier()), nil
+}
+
+func (cc *EDInfoCenterClient) GetCarriersNear(origin string, maxDistance float64, limit int) ([]*edGalaxy.CarrierInfo, error) {
+	var rpl *pb.CarriersReply
+	var cerr error = nil
+
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetCarriersNear(ctx, &pb.CarriersNearRequest{Origin: origin, MaxDistance: maxDistance, Limit: int64(limit)})
+	}
+
+	err := callRpc(cc.addr, call)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get carriers: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+
+	pbCarriers := rpl.GetCarriers()
+	carriers := make([]*edGalaxy.CarrierInfo, len(pbCarriers))
+	for i, c := range pbCarriers {
+		carriers[i] = pbCarrierInfo2galaxy(c)
+	}
+	return carriers, nil
+}
+
+/*
+	Streams the events to onEvent until the context is done or the server is gone
+*/
+func (cc *EDInfoCenterClient) SubscribeEvents(ctx context.Context, rq *pb.EventsSubscriptionRequest, onEvent func(*pb.EDDNEvent)) error {
+	if len(cc.addr) < 5 {
+		return errors.New("Galaxy information server is not configured")
+	}
+	conn, err := grpc.Dial(cc.addr, grpc.WithInsecure())
+	if err != nil {
+		log.Printf("did not connect: %v", err)
+		return errors.New("Galaxy information server is not available")
+	}
+	defer conn.Close()
+
+	stream, err := pb.NewEDInfoCenterClient(conn).SubscribeEvents(ctx, rq)
+	if err != nil {
+		return err
+	}
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		onEvent(e)
+	}
+}
+
+func (cc *EDInfoCenterClient) GetFactionInfo(name string) (*edGalaxy.FactionInfo, error, []string) {
+	var rpl *pb.FactionInfoReply
+	var cerr error = nil
+
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		rpl, cerr = c.GetFactionInfo(ctx, &pb.FactionByNameRequest{Name: name})
+	}
+
+	err := callRpc(cc.addr, call)
+
+	if err != nil {
+		return nil, err, nil
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get faction info: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction"), nil
+	}
+
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error), rpl.GetSuggestedFactions()
+	}
+
+	f := rpl.GetFaction()
+	pbPresences := f.GetPresences()
+	presences := make([]*edGalaxy.FactionPresence, len(pbPresences))
+	for i, fp := range pbPresences {
+		presences[i] = &edGalaxy.FactionPresence{
+			SystemName:    fp.GetSystemName(),
+			Influence:     fp.GetInfluence(),
+			State:         fp.GetState(),
+			IsControlling: fp.GetIsControlling()}
+	}
+	return &edGalaxy.FactionInfo{
+		Name:            f.GetName(),
+		Government:      f.GetGovernment(),
+		Allegiance:      f.GetAllegiance(),
+		State:           f.GetState(),
+		HomeSystem:      f.GetHomeSystem(),
+		IsPlayerFaction: f.GetIsPlayerFaction(),
+		Updated:         f.GetUpdated(),
+		Presences:       presences}, nil, nil
+}
+
+/*
+	The faction influence in every system it was seen, days <= 0 - as much as known
+*/
+func (cc *EDInfoCenterClient) GetFactionInfluenceHistory(faction string, days int) (*edGalaxy.InfluenceHistory, error) {
+	return cc.getInfluenceHistory(faction, days, false)
+}
+
+/*
+	The influence of every faction seen in the system
+*/
+func (cc *EDInfoCenterClient) GetSystemInfluenceHistory(system string, days int) (*edGalaxy.InfluenceHistory, error) {
+	return cc.getInfluenceHistory(system, days, true)
+}
+
+func (cc *EDInfoCenterClient) getInfluenceHistory(name string, days int, bySystem bool) (*edGalaxy.InfluenceHistory, error) {
+	var rpl *pb.InfluenceHistoryReply
+	var cerr error = nil
+
+	rq := &pb.InfluenceHistoryRequest{Name: name, Days: int64(days)}
+	call := func(c pb.EDInfoCenterClient, ctx context.Context) {
+		if bySystem {
+			rpl, cerr = c.GetSystemInfluenceHistory(ctx, rq)
+		} else {
+			rpl, cerr = c.GetFactionInfluenceHistory(ctx, rq)
+		}
+	}
+
+	err := callRpc(cc.addr, call)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cerr != nil {
+		log.Printf("Could not get influence history: %v", cerr)
+		return nil, errors.New("Galaxy information server malfunction")
+	}
+
+	if len(rpl.Error) != 0 {
+		return nil, errors.New(rpl.Error)
+	}
+
+	pbSeries := rpl.GetSeries()
+	series := make([]*edGalaxy.InfluenceSeries, len(pbSeries))
+	for i, s := range pbSeries {
+		pbPoints := s.GetPoints()
+		points := make([]*edGalaxy.InfluencePoint, len(pbPoints))
+		for j, p := range pbPoints {
+			points[j] = &edGalaxy.InfluencePoint{
+				Timestamp:        p.GetTimestamp(),
+				Influence:        p.GetInfluence(),
+				State:            p.GetState(),
+				PendingStates:    p.GetPendingStates(),
+				RecoveringStates: p.GetRecoveringStates()}
+		}
+		series[i] = &edGalaxy.InfluenceSeries{Name: s.GetName(), Points: points}
+	}
+	return &edGalaxy.InfluenceHistory{Name: rpl.GetName(), Series: series}, nil
+}
+
 func pbPoint3D2galaxy(p *pb.Point3D) *edGalaxy.Point3D {
 	if p == nil {
 		return nil
@@ -209,6 +797,22 @@ func pbPoint3D2galaxy(p *pb.Point3D) *edGalaxy.Point3D {
 	return &edGalaxy.Point3D{X: p.X, Y: p.Y, Z: p.Z}
 }
 
+func pbStationActivity2galaxyStationActivity(pbStat []*pb.StationActivity) []*edGalaxy.StationActivity {
+	stat := make([]*edGalaxy.StationActivity, 0, len(pbStat))
+	for _, s := range pbStat {
+		if s == nil {
+			continue
+		}
+		stat = append(stat, &edGalaxy.StationActivity{
+			Name:       s.GetName(),
+			SystemName: s.GetSystemName(),
+			Distance:   s.GetDistance(),
+			NumDocks:   s.GetNumDocks(),
+			Docks:      pbActivityStatItems2galaxyActivityStatItems(s.GetDocks())})
+	}
+	return stat
+}
+
 func pbActivityStatItems2galaxyActivityStatItems(pbActivity []*pb.ActivityStatItem) []*edGalaxy.ActivityStatItem {
 	if pbActivity == nil {
 		return nil
@@ -241,6 +845,16 @@ func pbSystemVisitsStat2galaxySystemVisitsStatCalculated(pbStat []*pb.SystemVisi
 	return stat
 }
 
+func pbStarInfo2galaxy(s *pb.StarInfo) *edGalaxy.StarInfo {
+	if s == nil {
+		return nil
+	}
+	return &edGalaxy.StarInfo{
+		Name:        s.GetName(),
+		Type:        s.GetType(),
+		IsScoopable: s.GetIsScoopable()}
+}
+
 func pmPopSystemBriefInfo2galaxy(i *pb.PopulatedSystemBriefInfo) *edGalaxy.BriefSystemInfo {
 	if i == nil {
 		return nil
@@ -272,7 +886,9 @@ func pbSystemSummary2galaxy(s *pb.SystemSummary) *edGalaxy.SystemSummary {
 		return nil
 	}
 	return &edGalaxy.SystemSummary{
-		Name:      s.GetName(),
-		Coords:    pbPoint3D2galaxy(s.GetCoords()),
-		BriefInfo: pmPopSystemBriefInfo2galaxy(s.GetPopSystemInfo())}
+		Name:           s.GetName(),
+		Coords:         pbPoint3D2galaxy(s.GetCoords()),
+		BriefInfo:      pmPopSystemBriefInfo2galaxy(s.GetPopSystemInfo()),
+		PrimaryStar:    pbStarInfo2galaxy(s.GetPrimaryStar()),
+		LastEDDNUpdate: s.GetLastEddnUpdate()}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"strings"
 
 	empty "github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context"
@@ -18,6 +19,10 @@ import (
 	"sync/atomic"
 )
 
+const (
+	maxInfluenceDays = 90
+)
+
 type GrpcServerConf struct {
 	Port    string
 	Enabled bool
@@ -27,6 +32,11 @@ type GIServer struct {
 	eddbInfo           atomic.Value
 	edsmc              *edsm.EDSMConnector
 	visitsStatProvider edGalaxy.VisitsStatProvider
+	influenceProvider  edGalaxy.InfluenceHistoryProvider
+	bodiesProvider     edGalaxy.BodiesProvider
+	carriersProvider   edGalaxy.CarriersProvider
+	uploadersProvider  edGalaxy.UploaderStatProvider
+	events             *EventFeed
 	cfg                GrpcServerConf
 	s                  *grpc.Server
 }
@@ -47,6 +57,26 @@ func (s *GIServer) SetVisitsStatProvider(prov edGalaxy.VisitsStatProvider) {
 	s.visitsStatProvider = prov
 }
 
+func (s *GIServer) SetInfluenceHistoryProvider(prov edGalaxy.InfluenceHistoryProvider) {
+	s.influenceProvider = prov
+}
+
+func (s *GIServer) SetBodiesProvider(prov edGalaxy.BodiesProvider) {
+	s.bodiesProvider = prov
+}
+
+func (s *GIServer) SetCarriersProvider(prov edGalaxy.CarriersProvider) {
+	s.carriersProvider = prov
+}
+
+func (s *GIServer) SetUploaderStatProvider(prov edGalaxy.UploaderStatProvider) {
+	s.uploadersProvider = prov
+}
+
+func (s *GIServer) SetEventFeed(feed *EventFeed) {
+	s.events = feed
+}
+
 func (s *GIServer) getSystemCoords(systemName string) (*edGalaxy.Point3D, bool) {
 	ss, known := s.getSystemSummaryByName(systemName)
 	if !known {
@@ -78,6 +108,10 @@ func fmtUnknownSystem(nm string) string {
 	return fmt.Sprintf("System '%s' is not known to me", nm)
 }
 
+func fmtUnknownFaction(nm string) string {
+	return fmt.Sprintf("Faction '%s' is not known to me", nm)
+}
+
 func fmtNonHabitableSystem(nm string) string {
 	return fmt.Sprintf("System '%s' is not habitable", nm)
 }
@@ -104,6 +138,13 @@ func galaxyBriefInfo2pbPopInfo(i *edGalaxy.BriefSystemInfo) *pb.PopulatedSystemB
 		Economy:      i.Economy}
 }
 
+func galaxyStarInfo2pb(s *edGalaxy.StarInfo) *pb.StarInfo {
+	if s == nil {
+		return nil
+	}
+	return &pb.StarInfo{Name: s.Name, Type: s.Type, IsScoopable: s.IsScoopable}
+}
+
 func galaxyShortFactionState2pb(s *edGalaxy.ShortFactionState) *pb.ShortFactionState {
 	if s == nil {
 		return nil
@@ -132,6 +173,19 @@ func galaxyActivityStatItem2pb(gstat []*edGalaxy.ActivityStatItem) ([]*pb.Activi
 	return pbStat
 }
 
+func galaxyStationActivity2pb(gstat []*edGalaxy.StationActivity) []*pb.StationActivity {
+	pbStat := make([]*pb.StationActivity, len(gstat))
+	for i, s := range gstat {
+		pbStat[i] = &pb.StationActivity{
+			Name:       s.Name,
+			SystemName: s.SystemName,
+			Distance:   s.Distance,
+			NumDocks:   s.NumDocks,
+			Docks:      galaxyActivityStatItem2pb(s.Docks)}
+	}
+	return pbStat
+}
+
 func galaxyInterestingSystem4State2pb(s *edGalaxy.InterestingSystem4State) *pb.InterestingSystem4State {
 	if s == nil {
 		return nil
@@ -141,10 +195,11 @@ func galaxyInterestingSystem4State2pb(s *edGalaxy.InterestingSystem4State) *pb.I
 		pbFactions[i] = galaxyShortFactionState2pb(f)
 	}
 	return &pb.InterestingSystem4State{
-		Name:          s.Name,
-		Population:    s.Population,
-		Coords:        galaxyPoint2pb(s.Coords),
-		FactionStates: pbFactions}
+		Name:           s.Name,
+		Population:     s.Population,
+		Coords:         galaxyPoint2pb(s.Coords),
+		FactionStates:  pbFactions,
+		LastEddnUpdate: s.LastEDDNUpdate}
 }
 
 func galaxyDockableStationShortInfo2pb(s *edGalaxy.DockableStationShortInfo) *pb.DockableStationShortInfo {
@@ -180,9 +235,11 @@ func (p *grpcProcessor) GetSystemSummary(ctx context.Context, in *pb.SystemByNam
 	}
 
 	pbss := pb.SystemSummary{
-		Name:          ss.Name,
-		Coords:        galaxyPoint2pb(ss.Coords),
-		PopSystemInfo: galaxyBriefInfo2pbPopInfo(ss.BriefInfo)}
+		Name:           ss.Name,
+		Coords:         galaxyPoint2pb(ss.Coords),
+		PopSystemInfo:  galaxyBriefInfo2pbPopInfo(ss.BriefInfo),
+		PrimaryStar:    galaxyStarInfo2pb(ss.PrimaryStar),
+		LastEddnUpdate: ss.LastEDDNUpdate}
 
 	return &pb.SystemSummaryReply{Summary: &pbss}, nil
 }
@@ -267,7 +324,7 @@ func (p *grpcProcessor) GetMostVisitedSystems(ctx context.Context, in *pb.MostVi
 		return &pb.MostVisitedSystemsReply{Error: "Stat collector is not set"}, nil
 	}
 
-	stat, total, err := p.gi.visitsStatProvider.GetSystemVisitsStat(coords, in.GetMaxDistance(), int(in.GetLimit()))
+	stat, total, err := p.gi.visitsStatProvider.GetSystemVisitsStat(coords, in.GetMaxDistance(), in.GetMaxAge(), int(in.GetLimit()))
 	if err != nil {
 		log.Printf("GetSystemVisitsStat failed: %v", err)
 		return &pb.MostVisitedSystemsReply{Error: "Stat collector eroor detected"}, nil
@@ -294,18 +351,436 @@ func (p *grpcProcessor) GetGalaxyActivityStat(ctx context.Context, in *pb.Activi
 		return &pb.ActivityStatReply{Error: "Stat collector is not set"}, nil
 	}
 
-	stat := p.gi.visitsStatProvider.GetActivityStat(coords, in.GetMaxDistance())
+	stat := p.gi.visitsStatProvider.GetActivityStat(coords, in.GetMaxDistance(), in.GetWindow())
 
 	return &pb.ActivityStatReply{StatItems: galaxyActivityStatItem2pb(stat) }, nil
 }
 
+func (p *grpcProcessor) GetStationActivity(ctx context.Context, in *pb.StationActivityRequest) (*pb.StationActivityReply, error) {
+	nm := in.GetOrigin()
+	coords, known := p.gi.getSystemCoords(nm)
+	if !known {
+		return &pb.StationActivityReply{Error: fmtUnknownSystem(nm)}, nil
+	}
+	if p.gi.visitsStatProvider == nil {
+		return &pb.StationActivityReply{Error: "Stat collector is not set"}, nil
+	}
+
+	stat := p.gi.visitsStatProvider.GetStationActivity(coords, in.GetMaxDistance(), in.GetWindow(), int(in.GetLimit()))
+
+	return &pb.StationActivityReply{Stations: galaxyStationActivity2pb(stat)}, nil
+}
+
+func (p *grpcProcessor) GetTrendingSystems(ctx context.Context, in *pb.TrendingSystemsRequest) (*pb.TrendingSystemsReply, error) {
+	nm := in.GetOrigin()
+	coords, known := p.gi.getSystemCoords(nm)
+	if !known {
+		return &pb.TrendingSystemsReply{Error: fmtUnknownSystem(nm)}, nil
+	}
+	if p.gi.visitsStatProvider == nil {
+		return &pb.TrendingSystemsReply{Error: "Stat collector is not set"}, nil
+	}
+
+	trending := p.gi.visitsStatProvider.GetTrendingSystems(coords, in.GetMaxDistance(), in.GetWindow(), int(in.GetLimit()))
+	pbSystems := make([]*pb.TrendingSystem, len(trending))
+	for i, t := range trending {
+		pbSystems[i] = &pb.TrendingSystem{
+			Name:         t.Name,
+			Distance:     t.Distance,
+			Visits:       t.Visits,
+			Rate:         t.Rate,
+			BaselineRate: t.BaselineRate,
+			Score:        t.Score}
+	}
+	return &pb.TrendingSystemsReply{Systems: pbSystems}, nil
+}
+
+func (p *grpcProcessor) GetUploaderStat(ctx context.Context, in *pb.UploaderStatRequest) (*pb.UploaderStatReply, error) {
+	if p.gi.uploadersProvider == nil {
+		return &pb.UploaderStatReply{Error: "EDDN uploaders are not tracked"}, nil
+	}
+	nm := in.GetOrigin()
+	coords, known := p.gi.getSystemCoords(nm)
+	if !known {
+		return &pb.UploaderStatReply{Error: fmtUnknownSystem(nm)}, nil
+	}
+	stat, err := p.gi.uploadersProvider.GetUploaderStat(coords, in.GetMaxDistance(), in.GetWindow())
+	if err != nil {
+		return &pb.UploaderStatReply{Error: err.Error()}, nil
+	}
+	rpl := &pb.UploaderStatReply{
+		Uploaders: stat.Uploaders,
+		Messages:  stat.Messages,
+		Hours:     make([]*pb.UploaderStatItem, len(stat.Hours)),
+		Software:  make([]*pb.SoftwareShare, len(stat.Software))}
+	for i, h := range stat.Hours {
+		rpl.Hours[i] = &pb.UploaderStatItem{Timestamp: h.Timestamp, Uploaders: h.Uploaders, Messages: h.Messages}
+	}
+	for i, sw := range stat.Software {
+		rpl.Software[i] = &pb.SoftwareShare{Name: sw.Name, Uploaders: sw.Uploaders, Messages: sw.Messages}
+	}
+	return rpl, nil
+}
+
+func galaxyRoute2pb(r *edGalaxy.Route) *pb.RouteReply {
+	waypoints := make([]*pb.RouteWaypoint, len(r.Waypoints))
+	for i, w := range r.Waypoints {
+		waypoints[i] = &pb.RouteWaypoint{
+			Name:         w.Name,
+			Coords:       galaxyPoint2pb(w.Coords),
+			Distance:     w.Distance,
+			PrimaryStar:  galaxyStarInfo2pb(w.PrimaryStar),
+			Supercharged: w.Supercharged}
+	}
+	return &pb.RouteReply{
+		Waypoints:     waypoints,
+		Jumps:         int64(r.Jumps),
+		TotalDistance: r.TotalDistance}
+}
+
+func (p *grpcProcessor) GetRoute(ctx context.Context, in *pb.RouteRequest) (*pb.RouteReply, error) {
+	eddbInfo := p.gi.eddbInfo.Load().(*eddb.EDDBInfo)
+	if eddbInfo == nil {
+		return &pb.RouteReply{Error: "EDDB processor is not (yet) available"}, nil
+	}
+	nm := in.GetOrigin()
+	if _, known := eddbInfo.GetSystemByName(nm); !known {
+		return &pb.RouteReply{Error: fmtUnknownSystem(nm)}, nil
+	}
+	nm = in.GetDestination()
+	if _, known := eddbInfo.GetSystemByName(nm); !known {
+		return &pb.RouteReply{Error: fmtUnknownSystem(nm)}, nil
+	}
+	opts := &edGalaxy.RouteOptions{
+		ScoopableOnly: in.GetScoopableOnly(),
+		UseNeutrons:   in.GetUseNeutrons()}
+	route, err := eddbInfo.FindRoute(in.GetOrigin(), in.GetDestination(), in.GetJumpRange(), opts)
+	if err != nil {
+		return &pb.RouteReply{Error: err.Error()}, nil
+	}
+	return galaxyRoute2pb(route), nil
+}
+
+func galaxyTradeHop2pb(h *edGalaxy.TradeHop) *pb.TradeHop {
+	if h == nil {
+		return nil
+	}
+	return &pb.TradeHop{
+		Commodity:   h.Commodity,
+		FromStation: h.FromStation,
+		FromSystem:  h.FromSystem,
+		ToStation:   h.ToStation,
+		ToSystem:    h.ToSystem,
+		BuyPrice:    int64(h.BuyPrice),
+		SellPrice:   int64(h.SellPrice),
+		Units:       int64(h.Units),
+		Profit:      h.Profit,
+		Distance:    h.Distance}
+}
+
+func (p *grpcProcessor) FindTradeRoutes(ctx context.Context, in *pb.TradeRouteRequest) (*pb.TradeRouteReply, error) {
+	eddbInfo := p.gi.eddbInfo.Load().(*eddb.EDDBInfo)
+	if eddbInfo == nil {
+		return &pb.TradeRouteReply{Error: "EDDB processor is not (yet) available"}, nil
+	}
+	nm := in.GetOrigin()
+	if _, known := eddbInfo.GetSystemByName(nm); !known {
+		return &pb.TradeRouteReply{Error: fmtUnknownSystem(nm)}, nil
+	}
+	routes, err := eddbInfo.FindTradeRoutes(nm, int(in.GetCapacity()), in.GetJumpRange(), in.GetMinPad(), in.GetMaxMarketAge(), int(in.GetLimit()))
+	if err != nil {
+		return &pb.TradeRouteReply{Error: err.Error()}, nil
+	}
+	hops := make([]*pb.TradeHop, len(routes.Hops))
+	for i, h := range routes.Hops {
+		hops[i] = galaxyTradeHop2pb(h)
+	}
+	loops := make([]*pb.TradeRoundTrip, len(routes.RoundTrips))
+	for i, l := range routes.RoundTrips {
+		loops[i] = &pb.TradeRoundTrip{
+			Outbound: galaxyTradeHop2pb(l.Outbound),
+			Inbound:  galaxyTradeHop2pb(l.Inbound),
+			Profit:   l.Profit}
+	}
+	return &pb.TradeRouteReply{Hops: hops, RoundTrips: loops}, nil
+}
+
+func chkCommodityRequest(eddbInfo *eddb.EDDBInfo, cn string, nm string) *pb.FindCommodityReply {
+	if !eddbInfo.HasCommodity(cn) {
+		suggested := eddbInfo.GetSimilarCommodityNames(cn)
+		if len(suggested) > 10 {
+			suggested = suggested[:10]
+		}
+		return &pb.FindCommodityReply{Error: fmt.Sprintf("Commodity '%s' is not known to me", cn), SuggestedCommodities: suggested}
+	}
+	if _, known := eddbInfo.GetSystemByName(nm); !known {
+		suggested := eddbInfo.GetSimilarSystemNames(nm)
+		if len(suggested) > 10 {
+			suggested = suggested[:10]
+		}
+		return &pb.FindCommodityReply{Error: fmtUnknownSystem(nm), SuggestedSystems: suggested}
+	}
+	return nil
+}
+
+func suitablePoints2pbReply(spoints []*eddb.SuitablePoint, limit int) *pb.FindCommodityReply {
+	if limit > 0 && len(spoints) > limit {
+		spoints = spoints[:limit]
+	}
+	points := eddb.SuitablePoints2galaxy(spoints)
+	pbPoints := make([]*pb.SuitablePoint, len(points))
+	for i, sp := range points {
+		pbPoints[i] = &pb.SuitablePoint{
+			Station:        sp.Station,
+			System:         sp.System,
+			LandingPad:     sp.LandingPad,
+			Planetary:      sp.Planetary,
+			DistanceToStar: sp.DistanceToStar,
+			BuyPrice:       int64(sp.BuyPrice),
+			Supply:         int64(sp.Supply),
+			Distance:       sp.Distance,
+			MarketAge:      sp.MarketAge,
+			SellPrice:      int64(sp.SellPrice),
+			Demand:         int64(sp.Demand),
+			PriceSource:    sp.PriceSource}
+	}
+	return &pb.FindCommodityReply{Points: pbPoints}
+}
+
+func (p *grpcProcessor) FindCommodity(ctx context.Context, in *pb.FindCommodityRequest) (*pb.FindCommodityReply, error) {
+	eddbInfo := p.gi.eddbInfo.Load().(*eddb.EDDBInfo)
+	if eddbInfo == nil {
+		return &pb.FindCommodityReply{Error: "EDDB processor is not (yet) available"}, nil
+	}
+	if rpl := chkCommodityRequest(eddbInfo, in.GetCommodity(), in.GetOrigin()); rpl != nil {
+		return rpl, nil
+	}
+	spoints, err := eddbInfo.FindCommodity(in.GetCommodity(), in.GetOrigin(), int(in.GetMinSupply()), in.GetMinPad(), in.GetAllowPlanetary(),
+		in.GetMaxLocalDistance(), in.GetMaxDistance(), in.GetMaxMarketAge())
+	if err != nil {
+		return &pb.FindCommodityReply{Error: err.Error()}, nil
+	}
+	return suitablePoints2pbReply(spoints, int(in.GetLimit())), nil
+}
+
+func (p *grpcProcessor) FindSellPlaces(ctx context.Context, in *pb.SellCommodityRequest) (*pb.FindCommodityReply, error) {
+	eddbInfo := p.gi.eddbInfo.Load().(*eddb.EDDBInfo)
+	if eddbInfo == nil {
+		return &pb.FindCommodityReply{Error: "EDDB processor is not (yet) available"}, nil
+	}
+	if rpl := chkCommodityRequest(eddbInfo, in.GetCommodity(), in.GetOrigin()); rpl != nil {
+		return rpl, nil
+	}
+	spoints, err := eddbInfo.FindSellPlaces(in.GetCommodity(), in.GetOrigin(), int(in.GetTonnage()), in.GetMinPad(), in.GetAllowPlanetary(),
+		in.GetMaxLocalDistance(), in.GetMaxDistance(), in.GetMaxMarketAge())
+	if err != nil {
+		return &pb.FindCommodityReply{Error: err.Error()}, nil
+	}
+	return suitablePoints2pbReply(spoints, int(in.GetLimit())), nil
+}
+
+func galaxyInfluenceHistory2pb(h *edGalaxy.InfluenceHistory) *pb.InfluenceHistoryReply {
+	series := make([]*pb.InfluenceSeries, len(h.Series))
+	for i, s := range h.Series {
+		points := make([]*pb.InfluencePoint, len(s.Points))
+		for j, p := range s.Points {
+			points[j] = &pb.InfluencePoint{
+				Timestamp:        p.Timestamp,
+				Influence:        p.Influence,
+				State:            p.State,
+				PendingStates:    p.PendingStates,
+				RecoveringStates: p.RecoveringStates}
+		}
+		series[i] = &pb.InfluenceSeries{Name: s.Name, Points: points}
+	}
+	return &pb.InfluenceHistoryReply{Name: h.Name, Series: series}
+}
+
+func influenceDays(days int64) int {
+	if days <= 0 || days > maxInfluenceDays {
+		return maxInfluenceDays
+	}
+	return int(days)
+}
+
+func (p *grpcProcessor) GetFactionInfluenceHistory(ctx context.Context, in *pb.InfluenceHistoryRequest) (*pb.InfluenceHistoryReply, error) {
+	if p.gi.influenceProvider == nil {
+		return &pb.InfluenceHistoryReply{Error: "Influence history is not collected"}, nil
+	}
+	h, err := p.gi.influenceProvider.GetFactionInfluenceHistory(in.GetName(), influenceDays(in.GetDays()))
+	if err != nil {
+		return &pb.InfluenceHistoryReply{Error: err.Error()}, nil
+	}
+	return galaxyInfluenceHistory2pb(h), nil
+}
+
+func (p *grpcProcessor) GetSystemInfluenceHistory(ctx context.Context, in *pb.InfluenceHistoryRequest) (*pb.InfluenceHistoryReply, error) {
+	if p.gi.influenceProvider == nil {
+		return &pb.InfluenceHistoryReply{Error: "Influence history is not collected"}, nil
+	}
+	h, err := p.gi.influenceProvider.GetSystemInfluenceHistory(in.GetName(), influenceDays(in.GetDays()))
+	if err != nil {
+		return &pb.InfluenceHistoryReply{Error: err.Error()}, nil
+	}
+	return galaxyInfluenceHistory2pb(h), nil
+}
+
+func galaxyFactionInfo2pb(f *edGalaxy.FactionInfo) *pb.FactionInfo {
+	presences := make([]*pb.FactionPresence, len(f.Presences))
+	for i, fp := range f.Presences {
+		presences[i] = &pb.FactionPresence{
+			SystemName:    fp.SystemName,
+			Influence:     fp.Influence,
+			State:         fp.State,
+			IsControlling: fp.IsControlling}
+	}
+	return &pb.FactionInfo{
+		Name:            f.Name,
+		Government:      f.Government,
+		Allegiance:      f.Allegiance,
+		State:           f.State,
+		HomeSystem:      f.HomeSystem,
+		IsPlayerFaction: f.IsPlayerFaction,
+		Updated:         f.Updated,
+		Presences:       presences}
+}
+
+func (p *grpcProcessor) GetFactionInfo(ctx context.Context, in *pb.FactionByNameRequest) (*pb.FactionInfoReply, error) {
+	eddbInfo := p.gi.eddbInfo.Load().(*eddb.EDDBInfo)
+	if eddbInfo == nil {
+		return &pb.FactionInfoReply{Error: "EDDB processor is not (yet) available"}, nil
+	}
+	f, known := eddbInfo.GetFactionInfo(in.GetName())
+	if !known {
+		suggested := eddbInfo.GetSimilarFactionNames(in.GetName())
+		if len(suggested) > 10 {
+			suggested = suggested[:10]
+		}
+		return &pb.FactionInfoReply{Error: fmtUnknownFaction(in.GetName()), SuggestedFactions: suggested}, nil
+	}
+	return &pb.FactionInfoReply{Faction: galaxyFactionInfo2pb(f)}, nil
+}
+
+func galaxyBodyInfo2pb(b *edGalaxy.BodyInfo) *pb.BodyInfo {
+	return &pb.BodyInfo{
+		Name:                b.Name,
+		BodyId:              int64(b.BodyID),
+		IsStar:              b.IsStar,
+		Type:                b.Type,
+		DistanceFromArrival: b.DistanceFromArrival,
+		Landable:            b.Landable,
+		TerraformState:      b.TerraformState,
+		Atmosphere:          b.Atmosphere,
+		RingClasses:         b.RingClasses,
+		WasDiscovered:       b.WasDiscovered,
+		WasMapped:           b.WasMapped,
+		Updated:             b.Updated}
+}
+
+func (p *grpcProcessor) GetSystemBodies(ctx context.Context, in *pb.SystemByNameRequest) (*pb.SystemBodiesReply, error) {
+	if p.gi.bodiesProvider == nil {
+		return &pb.SystemBodiesReply{Error: "Body scans are not collected"}, nil
+	}
+	bodies, err := p.gi.bodiesProvider.GetSystemBodies(in.GetName())
+	if err != nil {
+		return &pb.SystemBodiesReply{Error: err.Error()}, nil
+	}
+	pbBodies := make([]*pb.BodyInfo, len(bodies))
+	for i, b := range bodies {
+		pbBodies[i] = galaxyBodyInfo2pb(b)
+	}
+	return &pb.SystemBodiesReply{Bodies: pbBodies}, nil
+}
+
+func galaxyCarrierInfo2pb(c *edGalaxy.CarrierInfo) *pb.CarrierInfo {
+	pc := &pb.CarrierInfo{
+		Callsign: c.Callsign,
+		MarketId: c.MarketID,
+		System:   c.System,
+		Services: c.Services,
+		LastSeen: c.LastSeen,
+		Distance: c.Distance}
+	if c.Coords != nil {
+		pc.Coords = &pb.Point3D{X: c.Coords.X, Y: c.Coords.Y, Z: c.Coords.Z}
+	}
+	return pc
+}
+
+func (p *grpcProcessor) GetCarrier(ctx context.Context, in *pb.CarrierRequest) (*pb.CarrierReply, error) {
+	if p.gi.carriersProvider == nil {
+		return &pb.CarrierReply{Error: "Fleet carriers are not tracked"}, nil
+	}
+	c, err := p.gi.carriersProvider.GetCarrier(in.GetCallsign())
+	if err != nil {
+		return &pb.CarrierReply{Error: err.Error()}, nil
+	}
+	return &pb.CarrierReply{Carrier: galaxyCarrierInfo2pb(c)}, nil
+}
+
+func (p *grpcProcessor) GetCarriersNear(ctx context.Context, in *pb.CarriersNearRequest) (*pb.CarriersReply, error) {
+	if p.gi.carriersProvider == nil {
+		return &pb.CarriersReply{Error: "Fleet carriers are not tracked"}, nil
+	}
+	if in.GetMaxDistance() <= 0 {
+		return &pb.CarriersReply{Error: "The distance must be positive"}, nil
+	}
+	nm := in.GetOrigin()
+	coords, known := p.gi.getSystemCoords(nm)
+	if !known {
+		return &pb.CarriersReply{Error: fmtUnknownSystem(nm)}, nil
+	}
+	carriers, err := p.gi.carriersProvider.GetCarriersNear(coords, in.GetMaxDistance(), int(in.GetLimit()))
+	if err != nil {
+		return &pb.CarriersReply{Error: err.Error()}, nil
+	}
+	pbCarriers := make([]*pb.CarrierInfo, len(carriers))
+	for i, c := range carriers {
+		pbCarriers[i] = galaxyCarrierInfo2pb(c)
+	}
+	return &pb.CarriersReply{Carriers: pbCarriers}, nil
+}
+
+func (p *grpcProcessor) SubscribeEvents(in *pb.EventsSubscriptionRequest, stream pb.EDInfoCenter_SubscribeEventsServer) error {
+	if p.gi.events == nil {
+		return errors.New("Event feed is not available")
+	}
+	filter := &eventFilter{events: make(map[string]bool), faction: strings.ToUpper(in.GetFaction())}
+	for _, e := range in.GetEvents() {
+		filter.events[strings.ToUpper(e)] = true
+	}
+	if nm := in.GetSystemName(); len(nm) > 0 {
+		if in.GetRadius() <= 0 {
+			return errors.New("The radius must be positive")
+		}
+		coords, known := p.gi.getSystemCoords(nm)
+		if !known {
+			return errors.New(fmtUnknownSystem(nm))
+		}
+		filter.center = coords
+		filter.radius = in.GetRadius()
+	}
+
+	sub := p.gi.events.subscribe(filter)
+	defer p.gi.events.unsubscribe(sub)
+	for {
+		select {
+		case e := <-sub.events:
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
 func (s *GIServer) Serve() error {
 	lis, err := net.Listen("tcp", s.cfg.Port)
 	if err != nil {
 		log.Printf("failed to listen: %v", err)
 		return err
 	}
-	s.s = grpc.NewServer()
+	s.s = grpc.NewServer(grpc.UnaryInterceptor(serverMetricsInterceptor))
 	pb.RegisterEDInfoCenterServer(s.s, &grpcProcessor{gi: s})
 	reflection.Register(s.s)
 
@@ -0,0 +1,198 @@
+package edgic
+
+import (
+	pb "goed/api/protobuf-spec"
+	"goed/edGalaxy"
+	"goed/eddb"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	eventSubscriberBuffer = 256
+)
+
+/*
+	The subscriber filter, the empty parts are not checked
+*/
+type eventFilter struct {
+	events  map[string]bool
+	center  *edGalaxy.Point3D
+	radius  float64
+	faction string // upper case
+}
+
+type eventSubscriber struct {
+	filter  *eventFilter
+	events  chan *pb.EDDNEvent
+	dropped int64
+}
+
+/*
+	EventFeed fans the jumps and the docks out to the SubscribeEvents streams.
+	The collector goroutine never waits for a subscriber: the events
+	a slow subscriber has no room for are dropped and counted.
+*/
+type EventFeed struct {
+	mtx         sync.RWMutex
+	subscribers map[*eventSubscriber]bool
+	dropped     int64
+}
+
+func NewEventFeed() *EventFeed {
+	return &EventFeed{subscribers: make(map[*eventSubscriber]bool)}
+}
+
+func (f *EventFeed) subscribe(filter *eventFilter) *eventSubscriber {
+	s := &eventSubscriber{filter: filter, events: make(chan *pb.EDDNEvent, eventSubscriberBuffer)}
+	f.mtx.Lock()
+	f.subscribers[s] = true
+	f.mtx.Unlock()
+	return s
+}
+
+func (f *EventFeed) unsubscribe(s *eventSubscriber) {
+	f.mtx.Lock()
+	delete(f.subscribers, s)
+	f.mtx.Unlock()
+	if dropped := atomic.LoadInt64(&s.dropped); dropped > 0 {
+		log.Printf("Event subscriber gone, %d events were dropped\n", dropped)
+	}
+}
+
+/*
+	The events dropped for the slow subscribers since the start
+*/
+func (f *EventFeed) Dropped() int64 {
+	return atomic.LoadInt64(&f.dropped)
+}
+
+func (f *EventFeed) Subscribers() int {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+	return len(f.subscribers)
+}
+
+func (ef *eventFilter) accepts(event string, coords []float64, factions func(string) bool) bool {
+	if len(ef.events) > 0 && !ef.events[strings.ToUpper(event)] {
+		return false
+	}
+	if ef.center != nil {
+		if len(coords) != 3 || ef.center.Distance(&edGalaxy.Point3D{X: coords[0], Y: coords[1], Z: coords[2]}) > ef.radius {
+			return false
+		}
+	}
+	return len(ef.faction) == 0 || factions(ef.faction)
+}
+
+func (f *EventFeed) publish(event *pb.EDDNEvent, accepts func(*eventFilter) bool) {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+	for s := range f.subscribers {
+		if !accepts(s.filter) {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+			atomic.AddInt64(&s.dropped, 1)
+			atomic.AddInt64(&f.dropped, 1)
+		}
+	}
+}
+
+func eddnMessage2pbEvent(m *eddb.EDDNMessage, event string, timestamp int64) *pb.EDDNEvent {
+	return &pb.EDDNEvent{
+		Event:        event,
+		Timestamp:    timestamp,
+		SoftwareName: m.Header.SoftwareName,
+		UploaderId:   m.Header.UploaderID}
+}
+
+func starPos2pb(pos []float64) *pb.Point3D {
+	if len(pos) != 3 {
+		return nil
+	}
+	return &pb.Point3D{X: pos[0], Y: pos[1], Z: pos[2]}
+}
+
+/*
+	eddb.FSDJumpListener implementation
+*/
+func (f *EventFeed) OnFSDJump(m *eddb.EDDNMessage, jump *eddb.FSDJumpMessage) {
+	if f.Subscribers() == 0 {
+		return
+	}
+	event := eddnMessage2pbEvent(m, jump.Event, jump.Timestamp.Unix())
+	event.Jump = &pb.FSDJumpEvent{
+		StarSystem:    jump.StarSystem,
+		Coords:        starPos2pb(jump.StarPos),
+		SystemAddress: jump.SystemAddress,
+		Population:    jump.Population,
+		Allegiance:    jump.SystemAllegiance,
+		Economy:       jump.SystemEconomy,
+		Government:    jump.SystemGovernment,
+		Security:      jump.SystemSecurity,
+		SystemFaction: jump.SystemFaction,
+		Factions:      make([]*pb.EventFactionState, len(jump.Factions))}
+	for i, fs := range jump.Factions {
+		pfs := &pb.EventFactionState{
+			Name:       fs.Name,
+			Allegiance: fs.Allegiance,
+			Government: fs.Government,
+			State:      fs.FactionState,
+			Influence:  fs.Influence}
+		for _, ps := range fs.PendingStates {
+			pfs.PendingStates = append(pfs.PendingStates, ps.State)
+		}
+		for _, rs := range fs.RecoveringStates {
+			pfs.RecoveringStates = append(pfs.RecoveringStates, rs.State)
+		}
+		event.Jump.Factions[i] = pfs
+	}
+	present := func(faction string) bool {
+		if strings.ToUpper(jump.SystemFaction) == faction {
+			return true
+		}
+		for _, fs := range jump.Factions {
+			if strings.ToUpper(fs.Name) == faction {
+				return true
+			}
+		}
+		return false
+	}
+	f.publish(event, func(ef *eventFilter) bool {
+		return ef.accepts(jump.Event, jump.StarPos, present)
+	})
+}
+
+/*
+	eddb.DockedListener implementation
+*/
+func (f *EventFeed) OnDocked(m *eddb.EDDNMessage, docked *eddb.DockedMessage) {
+	if f.Subscribers() == 0 {
+		return
+	}
+	event := eddnMessage2pbEvent(m, docked.Event, docked.Timestamp.Unix())
+	event.Docked = &pb.DockedEvent{
+		StarSystem:        docked.StarSystem,
+		Coords:            starPos2pb(docked.StarPos),
+		SystemAddress:     docked.SystemAddress,
+		StationName:       docked.StationName,
+		StationType:       docked.StationType,
+		MarketId:          int64(docked.MarketID),
+		DistFromStarLs:    docked.DistFromStarLS,
+		StationFaction:    docked.StationFaction,
+		StationAllegiance: docked.StationAllegiance,
+		StationGovernment: docked.StationGovernment,
+		StationEconomy:    docked.StationEconomy,
+		StationServices:   docked.StationServices}
+	owns := func(faction string) bool {
+		return strings.ToUpper(docked.StationFaction) == faction
+	}
+	f.publish(event, func(ef *eventFilter) bool {
+		return ef.accepts(docked.Event, docked.StarPos, owns)
+	})
+}
@@ -0,0 +1,43 @@
+package edgic
+
+import (
+	"goed/edGalaxy"
+	"goed/eddb"
+	"testing"
+	"time"
+)
+
+func TestEventFeedFiltersAndDrops(t *testing.T) {
+	feed := NewEventFeed()
+	near := feed.subscribe(&eventFilter{events: map[string]bool{"FSDJUMP": true}, center: &edGalaxy.Point3D{}, radius: 10})
+	faction := feed.subscribe(&eventFilter{events: map[string]bool{}, faction: "MOTHER GAIA"})
+
+	m := &eddb.EDDNMessage{}
+	jump := &eddb.FSDJumpMessage{Event: "FSDJump", StarSystem: "Sol", StarPos: []float64{0, 0, 0}, SystemFaction: "Mother Gaia", Timestamp: time.Now()}
+	far := &eddb.FSDJumpMessage{Event: "FSDJump", StarSystem: "Colonia", StarPos: []float64{-9530, -910, 19808}, Timestamp: time.Now()}
+	docked := &eddb.DockedMessage{Event: "Docked", StarSystem: "Sol", StarPos: []float64{0, 0, 0}, StationFaction: "Mother Gaia", Timestamp: time.Now()}
+
+	feed.OnFSDJump(m, jump)
+	feed.OnFSDJump(m, far)
+	feed.OnDocked(m, docked)
+
+	if len(near.events) != 1 || (<-near.events).Jump.StarSystem != "Sol" {
+		t.Fatalf("Expected the jump to Sol only")
+	}
+	if len(faction.events) != 2 {
+		t.Fatalf("Expected the jump and the dock of the faction, got %d", len(faction.events))
+	}
+
+	// nobody reads: the feed must not block
+	for i := 0; i < eventSubscriberBuffer+10; i++ {
+		feed.OnFSDJump(m, jump)
+	}
+	if feed.Dropped() == 0 {
+		t.Fatalf("Expected dropped events")
+	}
+	feed.unsubscribe(near)
+	feed.unsubscribe(faction)
+	if feed.Subscribers() != 0 {
+		t.Fatalf("Expected no subscribers")
+	}
+}
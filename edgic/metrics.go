@@ -0,0 +1,39 @@
+package edgic
+
+import (
+	"path"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"goed/metrics"
+)
+
+var (
+	grpcServerSeconds = metrics.NewHistogramVec("edic_grpc_server_duration_seconds",
+		"The info center calls served by the method", "method", nil)
+	grpcClientSeconds = metrics.NewHistogramVec("edic_grpc_client_duration_seconds",
+		"The info center calls made by the method", "method", nil)
+	grpcClientFailures = metrics.NewCounterVec("edic_grpc_client_failures_total",
+		"The info center calls failed by the grpc status code", "code")
+)
+
+/*
+	The errors the handlers report in the replies are not seen here,
+	only the time the calls take
+*/
+func serverMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	defer grpcServerSeconds.With(path.Base(info.FullMethod)).ObserveSince(time.Now())
+	return handler(ctx, req)
+}
+
+func clientMetricsInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	defer grpcClientSeconds.With(path.Base(method)).ObserveSince(time.Now())
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil {
+		grpcClientFailures.With(status.Code(err).String()).Inc()
+	}
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"goed/edGalaxy"
+	"goed/metrics"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -13,6 +14,14 @@ import (
 	"time"
 )
 
+var (
+	edsmCacheLookups = metrics.NewCounterVec("edsm_cache_lookups_total",
+		"The EDSM system cache lookups by the result: hit, stale (expired but served) or miss (absent or expired)", "result")
+	edsmFetches = metrics.NewCounterVec("edsm_fetches_total",
+		"The EDSM system fetches by the result: ok, error or busy (no free fetcher)", "result")
+	edsmFetchSeconds = metrics.NewHistogram("edsm_fetch_duration_seconds", "The EDSM system fetch time", nil)
+)
+
 func edsmSysInfo2galaxyBriefSystemInfo(si *EDSMSysInfo) *edGalaxy.BriefSystemInfo {
 	if si == nil {
 		return nil
@@ -199,30 +208,38 @@ func (c *EDSMConnector) GetSystemInfo(systemName string, rplChannel chan *FetchE
 	if here {
 		if time.Now().Sub(cs.timestamp).Hours() < 1 {
 			log.Printf("Returning cached system %s\n", systemName)
+			edsmCacheLookups.With("hit").Inc()
 			rplChannel <- &FetchEDSMSystemReply{systemName, cs.system, nil}
 			return
 		}
 		log.Printf("System %s is expired in the cache\n", systemName)
 		if !mayAskEDSM {
 			log.Printf("Returning EXPIRED cached system %s (no free slots)\n", systemName)
+			edsmCacheLookups.With("stale").Inc()
 			rplChannel <- &FetchEDSMSystemReply{systemName, cs.system, nil}
 			return
 		}
 	}
 
+	edsmCacheLookups.With("miss").Inc()
 	if !mayAskEDSM {
+		edsmFetches.With("busy").Inc()
 		rplChannel <- &FetchEDSMSystemReply{systemName, nil, errors.New("all fetchers are busy")}
 		return
 	}
 
 	c.incAliveRequestsCount()
+	fetchStart := time.Now()
 	s, err := c.fetchSystem(systemName)
+	edsmFetchSeconds.ObserveSince(fetchStart)
 	c.decAliveRequestsCount()
 
 	if err != nil {
+		edsmFetches.With("error").Inc()
 		rplChannel <- &FetchEDSMSystemReply{systemName, nil, err}
 		return
 	}
+	edsmFetches.With("ok").Inc()
 	usn = normalizeSystemName(s.Name)
 
 	if len(usn) > 1 {
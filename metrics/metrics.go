@@ -0,0 +1,361 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+	The latency buckets in seconds, from 5ms to 10s
+*/
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type metric interface {
+	write(w *bufio.Writer)
+}
+
+/*
+	Registry keeps the metrics in the order they were registered
+	and writes them in the Prometheus text format
+*/
+type Registry struct {
+	mtx     sync.Mutex
+	metrics []metric
+	names   map[string]bool
+}
+
+var defaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]bool)}
+}
+
+/*
+	The metrics are registered once, at the package init as a rule,
+	so a second registration of the name is a bug
+*/
+func (r *Registry) register(name string, m metric) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.names[name] {
+		panic("metrics: " + name + " is registered twice")
+	}
+	r.names[name] = true
+	r.metrics = append(r.metrics, m)
+}
+
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mtx.Lock()
+	metrics := r.metrics
+	r.mtx.Unlock()
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		m.write(bw)
+	}
+	return bw.Flush()
+}
+
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if err := r.WriteText(w); err != nil {
+			log.Printf("Metrics write failed: %v\n", err)
+		}
+	})
+}
+
+/*
+	Serves the metrics of the default registry
+*/
+func Handler() http.Handler {
+	return defaultRegistry.Handler()
+}
+
+func writeHeader(w *bufio.Writer, name string, help string, typ string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, strings.Replace(help, "\n", " ", -1), name, typ)
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+/*
+	The label pairs in braces, empty names are skipped
+*/
+func formatLabels(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if len(pairs[i]) > 0 {
+			parts = append(parts, pairs[i]+`="`+labelEscaper.Replace(pairs[i+1])+`"`)
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+/*
+	Counter only goes up
+*/
+type Counter struct {
+	name  string
+	help  string
+	value uint64
+}
+
+func (r *Registry) NewCounter(name string, help string) *Counter {
+	c := &Counter{name: name, help: help}
+	r.register(name, c)
+	return c
+}
+
+func NewCounter(name string, help string) *Counter {
+	return defaultRegistry.NewCounter(name, help)
+}
+
+func (c *Counter) Inc() {
+	atomic.AddUint64(&c.value, 1)
+}
+
+func (c *Counter) Add(n uint64) {
+	atomic.AddUint64(&c.value, n)
+}
+
+func (c *Counter) Value() uint64 {
+	return atomic.LoadUint64(&c.value)
+}
+
+func (c *Counter) write(w *bufio.Writer) {
+	writeHeader(w, c.name, c.help, "counter")
+	fmt.Fprintf(w, "%s %d\n", c.name, c.Value())
+}
+
+/*
+	CounterVec keeps a counter per value of its only label,
+	the values are to be few: methods, results and alike
+*/
+type CounterVec struct {
+	name     string
+	help     string
+	label    string
+	mtx      sync.Mutex
+	counters map[string]*Counter
+}
+
+func (r *Registry) NewCounterVec(name string, help string, label string) *CounterVec {
+	v := &CounterVec{name: name, help: help, label: label, counters: make(map[string]*Counter)}
+	r.register(name, v)
+	return v
+}
+
+func NewCounterVec(name string, help string, label string) *CounterVec {
+	return defaultRegistry.NewCounterVec(name, help, label)
+}
+
+func (v *CounterVec) With(value string) *Counter {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	c, exists := v.counters[value]
+	if !exists {
+		c = &Counter{name: v.name}
+		v.counters[value] = c
+	}
+	return c
+}
+
+func (v *CounterVec) write(w *bufio.Writer) {
+	v.mtx.Lock()
+	values := make([]string, 0, len(v.counters))
+	for value := range v.counters {
+		values = append(values, value)
+	}
+	v.mtx.Unlock()
+	if len(values) == 0 {
+		return
+	}
+	sort.Strings(values)
+	writeHeader(w, v.name, v.help, "counter")
+	for _, value := range values {
+		fmt.Fprintf(w, "%s%s %d\n", v.name, formatLabels(v.label, value), v.With(value).Value())
+	}
+}
+
+/*
+	The value is read when the metrics are written
+*/
+type funcMetric struct {
+	name  string
+	help  string
+	typ   string
+	value func() float64
+}
+
+func (m *funcMetric) write(w *bufio.Writer) {
+	writeHeader(w, m.name, m.help, m.typ)
+	fmt.Fprintf(w, "%s %s\n", m.name, formatValue(m.value()))
+}
+
+/*
+	For the counters kept elsewhere
+*/
+func (r *Registry) NewCounterFunc(name string, help string, value func() float64) {
+	r.register(name, &funcMetric{name: name, help: help, typ: "counter", value: value})
+}
+
+func NewCounterFunc(name string, help string, value func() float64) {
+	defaultRegistry.NewCounterFunc(name, help, value)
+}
+
+func (r *Registry) NewGaugeFunc(name string, help string, value func() float64) {
+	r.register(name, &funcMetric{name: name, help: help, typ: "gauge", value: value})
+}
+
+func NewGaugeFunc(name string, help string, value func() float64) {
+	defaultRegistry.NewGaugeFunc(name, help, value)
+}
+
+/*
+	Histogram counts the observations by the upper bounds of the buckets
+*/
+type Histogram struct {
+	buckets []float64 // sorted
+	mtx     sync.Mutex
+	counts  []uint64 // per bucket, not cumulative
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(buckets []float64) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	return &Histogram{buckets: b, counts: make([]uint64, len(b))}
+}
+
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	h.mtx.Lock()
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.sum += v
+	h.count++
+	h.mtx.Unlock()
+}
+
+/*
+	Observes the seconds passed since start, to be deferred
+*/
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+func (h *Histogram) writeSeries(w *bufio.Writer, name string, label string, value string) {
+	h.mtx.Lock()
+	counts := append([]uint64(nil), h.counts...)
+	sum, count := h.sum, h.count
+	h.mtx.Unlock()
+	var cumulative uint64 = 0
+	for i, b := range h.buckets {
+		cumulative += counts[i]
+		fmt.Fprintf(w, "%s_bucket%s %d\n", name, formatLabels(label, value, "le", formatValue(b)), cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket%s %d\n", name, formatLabels(label, value, "le", "+Inf"), count)
+	fmt.Fprintf(w, "%s_sum%s %s\n", name, formatLabels(label, value), formatValue(sum))
+	fmt.Fprintf(w, "%s_count%s %d\n", name, formatLabels(label, value), count)
+}
+
+type namedHistogram struct {
+	*Histogram
+	name string
+	help string
+}
+
+func (h *namedHistogram) write(w *bufio.Writer) {
+	writeHeader(w, h.name, h.help, "histogram")
+	h.writeSeries(w, h.name, "", "")
+}
+
+/*
+	The buckets are DefBuckets if empty
+*/
+func (r *Registry) NewHistogram(name string, help string, buckets []float64) *Histogram {
+	h := &namedHistogram{Histogram: newHistogram(buckets), name: name, help: help}
+	r.register(name, h)
+	return h.Histogram
+}
+
+func NewHistogram(name string, help string, buckets []float64) *Histogram {
+	return defaultRegistry.NewHistogram(name, help, buckets)
+}
+
+/*
+	HistogramVec keeps a histogram per value of its only label
+*/
+type HistogramVec struct {
+	name       string
+	help       string
+	label      string
+	buckets    []float64
+	mtx        sync.Mutex
+	histograms map[string]*Histogram
+}
+
+func (r *Registry) NewHistogramVec(name string, help string, label string, buckets []float64) *HistogramVec {
+	v := &HistogramVec{name: name, help: help, label: label, buckets: buckets, histograms: make(map[string]*Histogram)}
+	r.register(name, v)
+	return v
+}
+
+func NewHistogramVec(name string, help string, label string, buckets []float64) *HistogramVec {
+	return defaultRegistry.NewHistogramVec(name, help, label, buckets)
+}
+
+func (v *HistogramVec) With(value string) *Histogram {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	h, exists := v.histograms[value]
+	if !exists {
+		h = newHistogram(v.buckets)
+		v.histograms[value] = h
+	}
+	return h
+}
+
+func (v *HistogramVec) write(w *bufio.Writer) {
+	v.mtx.Lock()
+	values := make([]string, 0, len(v.histograms))
+	for value := range v.histograms {
+		values = append(values, value)
+	}
+	v.mtx.Unlock()
+	if len(values) == 0 {
+		return
+	}
+	sort.Strings(values)
+	writeHeader(w, v.name, v.help, "histogram")
+	for _, value := range values {
+		v.With(value).writeSeries(w, v.name, v.label, value)
+	}
+}
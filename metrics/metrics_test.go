@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_received_total", "The received")
+	c.Add(3)
+	c.Inc()
+	v := r.NewCounterVec("test_dropped_total", "The dropped", "channel")
+	v.With("fsd").Inc()
+	v.With(`a"b`).Add(2)
+	r.NewCounterVec("test_unused_total", "Not used", "channel")
+	r.NewGaugeFunc("test_tracked", "The tracked", func() float64 { return 1.5 })
+	h := r.NewHistogramVec("test_seconds", "The time", "method", []float64{1, 0.1})
+	h.With("Get").Observe(0.05)
+	h.With("Get").Observe(0.5)
+	h.With("Get").Observe(2)
+
+	var buf bytes.Buffer
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText failed: %v", err)
+	}
+	expected := `# HELP test_received_total The received
+# TYPE test_received_total counter
+test_received_total 4
+# HELP test_dropped_total The dropped
+# TYPE test_dropped_total counter
+test_dropped_total{channel="a\"b"} 2
+test_dropped_total{channel="fsd"} 1
+# HELP test_tracked The tracked
+# TYPE test_tracked gauge
+test_tracked 1.5
+# HELP test_seconds The time
+# TYPE test_seconds histogram
+test_seconds_bucket{method="Get",le="0.1"} 1
+test_seconds_bucket{method="Get",le="1"} 2
+test_seconds_bucket{method="Get",le="+Inf"} 3
+test_seconds_sum{method="Get"} 2.55
+test_seconds_count{method="Get"} 3
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected output:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	r := NewRegistry()
+	r.NewHistogram("test_load_seconds", "The load", nil).Observe(0.005)
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("Unexpected content type %s", ct)
+	}
+	// the bounds are inclusive
+	if !strings.Contains(rec.Body.String(), "test_load_seconds_bucket{le=\"0.005\"} 1\n") {
+		t.Errorf("Unexpected output:\n%s", rec.Body.String())
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("test_total", "The first")
+	defer func() {
+		if recover() == nil {
+			t.Error("The second registration is not detected")
+		}
+	}()
+	r.NewCounter("test_total", "The second")
+}